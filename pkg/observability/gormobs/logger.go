@@ -0,0 +1,101 @@
+package gormobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// explainedPlaceholder - плейсхолдер PostgreSQL ($1) в виде, который оставляет Explain без значений ($1$)
+var explainedPlaceholder = regexp.MustCompile(`\$(\d+)\$`)
+
+// Logger передаёт журнал GORM в slog: ошибки запросов записываются с уровнем Error,
+// запросы дольше порога - Warn, остальные - Debug. Текст запроса выводится с плейсхолдерами
+// вместо значений параметров, поэтому email, хэши паролей и токенов в журнал не попадают.
+type Logger struct {
+	logger        *slog.Logger
+	slowThreshold time.Duration // 0 отключает предупреждения о медленных запросах
+	level         logger.LogLevel
+}
+
+// NewLogger создаёт журнал GORM поверх slog
+func NewLogger(l *slog.Logger, slowThreshold time.Duration) *Logger {
+	return &Logger{logger: l, slowThreshold: slowThreshold, level: logger.Info}
+}
+
+// LogMode реализует logger.Interface (logger.Silent отключает журнал, например в отдельных сессиях)
+func (l *Logger) LogMode(level logger.LogLevel) logger.Interface {
+	copied := *l
+	copied.level = level
+	return &copied
+}
+
+// Info реализует logger.Interface
+func (l *Logger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Info {
+		l.logger.InfoContext(ctx, fmt.Sprintf(msg, data...))
+	}
+}
+
+// Warn реализует logger.Interface
+func (l *Logger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Warn {
+		l.logger.WarnContext(ctx, fmt.Sprintf(msg, data...))
+	}
+}
+
+// Error реализует logger.Interface
+func (l *Logger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= logger.Error {
+		l.logger.ErrorContext(ctx, fmt.Sprintf(msg, data...))
+	}
+}
+
+// Trace реализует logger.Interface: вызывается после каждого SQL запроса
+func (l *Logger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	if l.level <= logger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	fc = placeholders(fc)
+	switch {
+	// Отсутствие записи - обычный результат поиска, а не ошибка
+	case err != nil && !errors.Is(err, gorm.ErrRecordNotFound) && l.level >= logger.Error:
+		sql, rows := fc()
+		l.logger.ErrorContext(ctx, "Ошибка SQL запроса",
+			"sql", sql, "rows", rows, "duration_ms", durationMs(elapsed), "error", err)
+	case l.slowThreshold > 0 && elapsed > l.slowThreshold && l.level >= logger.Warn:
+		sql, rows := fc()
+		l.logger.WarnContext(ctx, "Медленный SQL запрос",
+			"sql", sql, "rows", rows, "duration_ms", durationMs(elapsed), "threshold_ms", durationMs(l.slowThreshold))
+	case l.level >= logger.Info && l.logger.Enabled(ctx, slog.LevelDebug):
+		sql, rows := fc()
+		l.logger.DebugContext(ctx, "SQL запрос",
+			"sql", sql, "rows", rows, "duration_ms", durationMs(elapsed))
+	}
+}
+
+// ParamsFilter реализует gorm.ParamsFilter: значения параметров не подставляются в текст запроса
+func (l *Logger) ParamsFilter(_ context.Context, sql string, _ ...interface{}) (string, []interface{}) {
+	return sql, nil
+}
+
+// placeholders восстанавливает плейсхолдеры в тексте запроса, подготовленном без значений параметров
+func placeholders(fc func() (string, int64)) func() (string, int64) {
+	return func() (string, int64) {
+		sql, rows := fc()
+		return explainedPlaceholder.ReplaceAllString(sql, "$$$1"), rows
+	}
+}
+
+// durationMs переводит длительность в миллисекунды для журнала
+func durationMs(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
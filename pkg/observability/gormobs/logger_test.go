@@ -0,0 +1,83 @@
+package gormobs
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/utils/tests"
+)
+
+// user - модель с персональными данными для проверки журнала запросов
+type user struct {
+	ID           uint
+	Email        string
+	IIN          string
+	PasswordHash string
+}
+
+func TestLoggerKeepsParamsOutOfSQL(t *testing.T) {
+	var out bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&out, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	db, err := gorm.Open(tests.DummyDialector{}, &gorm.Config{Logger: NewLogger(logger, 0), DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const (
+		email        = "user@example.com"
+		iin          = "990101300123"
+		passwordHash = "$2a$10$hashvalue"
+	)
+	tests := []struct {
+		name  string
+		query func(db *gorm.DB) *gorm.DB
+	}{
+		{name: "where", query: func(db *gorm.DB) *gorm.DB { return db.Where("email = ?", email).Find(&[]user{}) }},
+		{name: "create", query: func(db *gorm.DB) *gorm.DB {
+			return db.Create(&user{Email: email, IIN: iin, PasswordHash: passwordHash})
+		}},
+		{name: "update", query: func(db *gorm.DB) *gorm.DB {
+			return db.Model(&user{ID: 1}).Updates(map[string]interface{}{"password_hash": passwordHash, "iin": iin})
+		}},
+		{name: "raw", query: func(db *gorm.DB) *gorm.DB { return db.Exec("UPDATE users SET email = ? WHERE iin = ?", email, iin) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out.Reset()
+			if err := tt.query(db).Error; err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(out.String(), `"sql":`) || !strings.Contains(out.String(), "?") {
+				t.Fatalf("log record %s has no SQL text with placeholders", out.String())
+			}
+			for _, value := range []string{email, iin, passwordHash} {
+				if strings.Contains(out.String(), value) {
+					t.Errorf("log record %s contains bound value %q", out.String(), value)
+				}
+			}
+		})
+	}
+}
+
+func TestPlaceholders(t *testing.T) {
+	tests := []struct {
+		explained string
+		want      string
+	}{
+		{explained: "SELECT * FROM users WHERE email = $1$", want: "SELECT * FROM users WHERE email = $1"},
+		{explained: "UPDATE users SET iin = $1$ WHERE id = $12$", want: "UPDATE users SET iin = $1 WHERE id = $12"},
+		{explained: "SELECT * FROM users WHERE email = ?", want: "SELECT * FROM users WHERE email = ?"},
+	}
+
+	for _, tt := range tests {
+		got, _ := placeholders(func() (string, int64) { return tt.explained, 0 })()
+		if got != tt.want {
+			t.Errorf("placeholders(%q) = %q, want %q", tt.explained, got, tt.want)
+		}
+	}
+}
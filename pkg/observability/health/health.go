@@ -0,0 +1,129 @@
+// Package health отвечает на проверки живости и готовности сервиса с базой данных.
+package health
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"observability/server"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Response представляет результат проверки живости или готовности сервиса
+type Response struct {
+	Status     string               `json:"status" example:"ready"`
+	Service    string               `json:"service" example:"auth-service"`
+	Components map[string]Component `json:"components,omitempty"`
+}
+
+// Component представляет состояние зависимости сервиса
+type Component struct {
+	Status    string       `json:"status" example:"up"`
+	LatencyMs int64        `json:"latency_ms" example:"2"`
+	Error     string       `json:"error,omitempty"`
+	Pool      *DBPoolStats `json:"pool,omitempty"`
+}
+
+// DBPoolStats представляет состояние пула соединений с базой данных
+type DBPoolStats struct {
+	MaxOpenConnections int   `json:"max_open_connections" example:"100"`
+	OpenConnections    int   `json:"open_connections" example:"3"`
+	InUse              int   `json:"in_use" example:"1"`
+	Idle               int   `json:"idle" example:"2"`
+	WaitCount          int64 `json:"wait_count" example:"0"`
+	WaitDurationMs     int64 `json:"wait_duration_ms" example:"0"`
+	MaxIdleClosed      int64 `json:"max_idle_closed" example:"0"`
+	MaxLifetimeClosed  int64 `json:"max_lifetime_closed" example:"0"`
+}
+
+// Handler обрабатывает проверки живости и готовности сервиса
+type Handler struct {
+	service   string
+	db        *sql.DB
+	readiness *server.Readiness
+	timeout   time.Duration
+}
+
+// NewHandler создаёт обработчик проверок состояния сервиса service
+func NewHandler(service string, db *sql.DB, readiness *server.Readiness, timeout time.Duration) *Handler {
+	return &Handler{
+		service:   service,
+		db:        db,
+		readiness: readiness,
+		timeout:   timeout,
+	}
+}
+
+// Live сообщает, что процесс жив (зависимости не проверяются)
+// @Summary Проверка живости
+// @Tags health
+// @Produce json
+// @Success 200 {object} health.Response
+// @Router /health/live [get]
+func (h *Handler) Live(c *gin.Context) {
+	c.JSON(http.StatusOK, Response{
+		Status:  "alive",
+		Service: h.service,
+	})
+}
+
+// Ready проверяет готовность принимать запросы: сервер не останавливается и база данных отвечает
+// @Summary Проверка готовности
+// @Description Проверяет доступность PostgreSQL и возвращает состояние пула соединений
+// @Tags health
+// @Produce json
+// @Success 200 {object} health.Response
+// @Failure 503 {object} health.Response
+// @Router /health/ready [get]
+func (h *Handler) Ready(c *gin.Context) {
+	database := h.checkDatabase(c.Request.Context())
+	response := Response{
+		Status:     "ready",
+		Service:    h.service,
+		Components: map[string]Component{"database": database},
+	}
+
+	switch {
+	case !h.readiness.IsReady():
+		response.Status = "shutting_down"
+	case database.Status != "up":
+		response.Status = "not_ready"
+	default:
+		c.JSON(http.StatusOK, response)
+		return
+	}
+	c.JSON(http.StatusServiceUnavailable, response)
+}
+
+// checkDatabase проверяет соединение с базой данных с ограничением по времени
+func (h *Handler) checkDatabase(ctx context.Context) Component {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	started := time.Now()
+	err := h.db.PingContext(ctx)
+	stats := h.db.Stats()
+
+	health := Component{
+		Status:    "up",
+		LatencyMs: time.Since(started).Milliseconds(),
+		Pool: &DBPoolStats{
+			MaxOpenConnections: stats.MaxOpenConnections,
+			OpenConnections:    stats.OpenConnections,
+			InUse:              stats.InUse,
+			Idle:               stats.Idle,
+			WaitCount:          stats.WaitCount,
+			WaitDurationMs:     stats.WaitDuration.Milliseconds(),
+			MaxIdleClosed:      stats.MaxIdleClosed,
+			MaxLifetimeClosed:  stats.MaxLifetimeClosed,
+		},
+	}
+	if err != nil {
+		health.Status = "down"
+		health.Error = err.Error()
+	}
+	return health
+}
@@ -0,0 +1,61 @@
+// Package logging настраивает структурированный журнал сервиса: JSON записи в stdout с уровнем
+// из конфигурации, идентификаторами запроса и трассировки из контекста и маскированием
+// персональных данных и секретов.
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"os"
+
+	"observability/tracing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Setup создаёт журнал с указанным уровнем и делает его журналом по умолчанию для slog
+// и стандартного пакета log
+func Setup(level slog.Level) *slog.Logger {
+	logger := slog.New(&contextHandler{Handler: slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level:       level,
+		ReplaceAttr: redactAttr,
+	})})
+	slog.SetDefault(logger)
+	return logger
+}
+
+// Fatal записывает ошибку в журнал и завершает процесс
+func Fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
+// contextHandler дополняет записи идентификаторами запроса и трассировки из контекста,
+// поэтому записи сервисов и репозиториев (slog.*Context) связываются с запросом
+type contextHandler struct {
+	slog.Handler
+}
+
+// Handle реализует slog.Handler
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if requestID := tracing.RequestIDFromContext(ctx); requestID != "" {
+		record.AddAttrs(slog.String("request_id", requestID))
+	}
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsValid() {
+		record.AddAttrs(
+			slog.String("trace_id", spanContext.TraceID().String()),
+			slog.String("span_id", spanContext.SpanID().String()),
+		)
+	}
+	return h.Handler.Handle(ctx, record)
+}
+
+// WithAttrs реализует slog.Handler
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup реализует slog.Handler
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,60 @@
+package logging
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Middleware записывает в журнал каждый запрос: метод, маршрут, путь, статус, время обработки
+// и пользователя, если он аутентифицирован. Идентификаторы запроса и трассировки добавляются
+// из контекста, поэтому middleware ставится после tracing.Middleware. Успешные проверки
+// состояния и запросы метрик пишутся с уровнем Debug, чтобы не засорять журнал.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		status := c.Writer.Status()
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		attrs := []slog.Attr{
+			slog.String("method", c.Request.Method),
+			slog.String("route", route),
+			slog.String("path", c.Request.URL.Path),
+			slog.Int("status", status),
+			slog.Float64("latency_ms", durationMs(time.Since(start))),
+			slog.String("client_ip", c.ClientIP()),
+		}
+		if userID, ok := c.Get("user_id"); ok {
+			attrs = append(attrs, slog.String("user_id", fmt.Sprint(userID)))
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, slog.String("error", strings.Join(c.Errors.Errors(), "; ")))
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		case strings.HasPrefix(route, "/health"):
+			level = slog.LevelDebug
+		}
+		slog.LogAttrs(c.Request.Context(), level, "HTTP запрос", attrs...)
+	}
+}
+
+// durationMs переводит длительность в миллисекунды для журнала
+func durationMs(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
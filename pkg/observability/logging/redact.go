@@ -0,0 +1,77 @@
+package logging
+
+import (
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+// redacted заменяет скрытые значения
+const redacted = "[REDACTED]"
+
+// sensitiveKeys - части ключей атрибутов, значения которых не выводятся
+var sensitiveKeys = []string{"password", "passwd", "secret", "token", "authorization", "cookie", "otp"}
+
+var (
+	// Email: сохраняется первый символ и домен (a***@example.com)
+	emailPattern = regexp.MustCompile(`([A-Za-z0-9])[A-Za-z0-9._%+\-]*@([A-Za-z0-9.\-]+\.[A-Za-z]{2,})`)
+
+	// Кандидаты в ИИН/БИН: 12 цифр подряд вместе с окружающим словом (UUID, более длинное
+	// число), которое проверяется в Redact
+	iinPattern = regexp.MustCompile(`[\w-]*\d{12}[\w-]*`)
+
+	// JWT (access токены, id_token провайдеров)
+	jwtPattern = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+
+	// Заголовок Authorization
+	bearerPattern = regexp.MustCompile(`(?i)(bearer\s+)[A-Za-z0-9._~+/=-]+`)
+
+	// Секреты в параметрах запроса и ссылках (?token=...) и в JSON ("password":"...")
+	queryPattern = regexp.MustCompile(`(?i)\b((?:[a-z_]*(?:password|secret|token))|code|state)=[^&\s"]+`)
+	jsonPattern  = regexp.MustCompile(`(?i)("(?:[a-z_]*(?:password|secret|token)|code|state)"\s*:\s*)"[^"]*"`)
+)
+
+// redactAttr маскирует значения атрибутов перед записью: по ключу (пароли, токены, секреты)
+// и по содержимому строк и ошибок (email, ИИН/БИН, токены), в том числе в тексте сообщения
+func redactAttr(_ []string, attr slog.Attr) slog.Attr {
+	if isSensitiveKey(attr.Key) {
+		return slog.String(attr.Key, redacted)
+	}
+
+	switch attr.Value.Kind() {
+	case slog.KindString:
+		return slog.String(attr.Key, Redact(attr.Value.String()))
+	case slog.KindAny:
+		if err, ok := attr.Value.Any().(error); ok {
+			return slog.String(attr.Key, Redact(err.Error()))
+		}
+	}
+	return attr
+}
+
+// isSensitiveKey проверяет, что ключ атрибута относится к секретам
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact маскирует в строке email, ИИН/БИН, токены и пароли
+func Redact(s string) string {
+	s = jwtPattern.ReplaceAllString(s, redacted)
+	s = bearerPattern.ReplaceAllString(s, "${1}"+redacted)
+	s = queryPattern.ReplaceAllString(s, "${1}="+redacted)
+	s = jsonPattern.ReplaceAllString(s, `${1}"`+redacted+`"`)
+	s = emailPattern.ReplaceAllString(s, "${1}***@${2}")
+	s = iinPattern.ReplaceAllStringFunc(s, func(match string) string {
+		if len(match) == 12 && strings.Trim(match, "0123456789") == "" {
+			return redacted
+		}
+		return match
+	})
+	return s
+}
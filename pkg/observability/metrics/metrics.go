@@ -0,0 +1,14 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Handler возвращает обработчик /metrics: все метрики реестра Prometheus по умолчанию,
+// включая метрики среды выполнения Go и процесса.
+// Метрики сервисов регистрируются в нём через promauto.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
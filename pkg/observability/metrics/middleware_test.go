@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMiddlewareLabelsByRoute(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(Middleware())
+	r.GET("/users/:id", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+
+	for _, path := range []string{"/users/1", "/users/2", "/unknown/path"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	// Ряды метрик соответствуют шаблону маршрута, а не конкретному URL
+	if got := testutil.ToFloat64(httpRequestsTotal.WithLabelValues("GET", "/users/:id", "204")); got != 2 {
+		t.Errorf("requests to /users/:id = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(httpRequestsTotal.WithLabelValues("GET", "unmatched", "404")); got != 1 {
+		t.Errorf("unmatched requests = %v, want 1", got)
+	}
+
+	// Обработчик отдаёт метрики из реестра по умолчанию
+	recorder := httptest.NewRecorder()
+	Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	for _, want := range []string{`http_requests_total{method="GET",route="/users/:id",status="204"} 2`, "http_request_duration_seconds_bucket", "go_goroutines"} {
+		if !strings.Contains(recorder.Body.String(), want) {
+			t.Errorf("/metrics does not contain %q", want)
+		}
+	}
+}
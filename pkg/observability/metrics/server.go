@@ -0,0 +1,35 @@
+package metrics
+
+import (
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Serve отдаёт /metrics на отдельном адресе addr (внутренний порт, который не публикуется наружу
+// вместе с портом API) и возвращает функцию остановки. Порт занимается сразу, поэтому ошибка
+// (например, порт занят) возвращается до запуска основного сервера.
+func Serve(addr string) (func() error, error) {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", Handler())
+
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Ошибка сервера метрик", "error", err)
+		}
+	}()
+	return srv.Close, nil
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// Config содержит настройки HTTP сервера и его остановки
+type Config struct {
+	Addr              string
+	ReadTimeout       time.Duration // Чтение всего запроса вместе с телом
+	ReadHeaderTimeout time.Duration // Чтение заголовков (защита от медленных клиентов)
+	WriteTimeout      time.Duration // Запись ответа
+	IdleTimeout       time.Duration // Простой keep-alive соединения
+	ShutdownDelay     time.Duration // Пауза после снятия готовности, чтобы балансировщик перестал слать запросы
+	ShutdownTimeout   time.Duration // Время на завершение обрабатываемых запросов
+}
+
+// Readiness хранит готовность сервиса принимать запросы. Снимается в начале остановки,
+// чтобы проверка готовности отвечала "не готов", пока завершаются текущие запросы.
+type Readiness struct {
+	ready atomic.Bool
+}
+
+// NewReadiness создаёт флаг готовности (изначально "не готов")
+func NewReadiness() *Readiness {
+	return &Readiness{}
+}
+
+// IsReady сообщает, готов ли сервис принимать запросы
+func (r *Readiness) IsReady() bool {
+	return r.ready.Load()
+}
+
+// Run запускает HTTP сервер и блокируется до SIGINT/SIGTERM или ошибки сервера.
+// При остановке:
+//  1. снимает готовность и ждёт ShutdownDelay;
+//  2. перестаёт принимать соединения и ждёт завершения текущих запросов (не дольше ShutdownTimeout);
+//  3. вызывает closers (например, закрытие пула соединений с БД).
+func Run(handler http.Handler, cfg Config, readiness *Readiness, closers ...func() error) error {
+	srv := &http.Server{
+		Addr:              cfg.Addr,
+		Handler:           handler,
+		ReadTimeout:       cfg.ReadTimeout,
+		ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+		WriteTimeout:      cfg.WriteTimeout,
+		IdleTimeout:       cfg.IdleTimeout,
+	}
+
+	// Порт занимается сразу, чтобы ошибка (например, порт занят) вернулась до отметки готовности
+	listener, err := net.Listen("tcp", cfg.Addr)
+	if err != nil {
+		return err
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- srv.Serve(listener)
+	}()
+	readiness.ready.Store(true)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	select {
+	case err := <-serveErr:
+		readiness.ready.Store(false)
+		return errors.Join(err, closeAll(closers))
+	case <-ctx.Done():
+	}
+
+	// Повторный сигнал завершает процесс немедленно
+	stop()
+
+	readiness.ready.Store(false)
+	time.Sleep(cfg.ShutdownDelay)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+
+	err = srv.Shutdown(shutdownCtx)
+	return errors.Join(err, closeAll(closers))
+}
+
+// closeAll вызывает все closers и объединяет их ошибки
+func closeAll(closers []func() error) error {
+	var errs []error
+	for _, closer := range closers {
+		errs = append(errs, closer())
+	}
+	return errors.Join(errs...)
+}
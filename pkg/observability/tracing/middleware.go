@@ -0,0 +1,34 @@
+package tracing
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ContextRequestID - ключ идентификатора запроса в gin.Context
+const ContextRequestID = "request_id"
+
+// Middleware начинает операцию трассировки (otelgin) для каждого запроса. Трассировка вызывающего
+// сервиса продолжается по заголовку traceparent; идентификатор запроса берётся из X-Request-ID
+// (или создаётся) и возвращается в ответе. Оба сохраняются в контексте запроса, поэтому
+// доступны сервисам, репозиториям и SQL запросам (через db.WithContext).
+//
+// otelgin восстанавливает исходный контекст запроса после обработки, поэтому middleware
+// ставится перед logging.Middleware: иначе запись журнала о запросе осталась бы без трассировки.
+func Middleware(serviceName string) gin.HandlersChain {
+	return gin.HandlersChain{
+		func(c *gin.Context) {
+			requestID := RequestID(c.GetHeader(HeaderRequestID))
+			c.Request = c.Request.WithContext(ContextWithRequestID(c.Request.Context(), requestID))
+			c.Set(ContextRequestID, requestID)
+			c.Header(HeaderRequestID, requestID)
+		},
+		otelgin.Middleware(serviceName),
+		func(c *gin.Context) {
+			trace.SpanFromContext(c.Request.Context()).SetAttributes(
+				attribute.String("http.request_id", RequestIDFromContext(c.Request.Context())))
+		},
+	}
+}
@@ -0,0 +1,94 @@
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMiddlewareContinuesTraceParent(t *testing.T) {
+	shutdown, err := Setup("test-service", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer shutdown()
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	tests := []struct {
+		name        string
+		traceParent string
+		wantRemote  bool
+	}{
+		{name: "valid sampled", traceParent: "00-" + traceID + "-00f067aa0ba902b7-01", wantRemote: true},
+		{name: "valid not sampled", traceParent: "00-" + traceID + "-00f067aa0ba902b7-00", wantRemote: true},
+		{name: "absent"},
+		{name: "zero trace id", traceParent: "00-00000000000000000000000000000000-00f067aa0ba902b7-01"},
+		{name: "zero span id", traceParent: "00-" + traceID + "-0000000000000000-01"},
+		{name: "short trace id", traceParent: "00-4bf92f3577b34da6-00f067aa0ba902b7-01"},
+		{name: "not hex", traceParent: "00-" + traceID + "-00f067aa0ba902zz-01"},
+		{name: "invalid version", traceParent: "ff-" + traceID + "-00f067aa0ba902b7-01"},
+		{name: "missing flags", traceParent: "00-" + traceID + "-00f067aa0ba902b7"},
+	}
+
+	gin.SetMode(gin.TestMode)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var spanContext trace.SpanContext
+			var requestID string
+			r := gin.New()
+			r.Use(Middleware("test-service")...)
+			r.GET("/ping", func(c *gin.Context) {
+				spanContext = trace.SpanContextFromContext(c.Request.Context())
+				requestID = RequestIDFromContext(c.Request.Context())
+				c.Status(http.StatusNoContent)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+			if tt.traceParent != "" {
+				req.Header.Set("traceparent", tt.traceParent)
+			}
+			req.Header.Set(HeaderRequestID, "req-1")
+			recorder := httptest.NewRecorder()
+			r.ServeHTTP(recorder, req)
+
+			if !spanContext.IsValid() {
+				t.Fatal("request has no span")
+			}
+			if got := spanContext.TraceID().String() == traceID; got != tt.wantRemote {
+				t.Errorf("trace id = %s, continued = %v, want %v", spanContext.TraceID(), got, tt.wantRemote)
+			}
+			if requestID != "req-1" || recorder.Header().Get(HeaderRequestID) != "req-1" {
+				t.Errorf("request id = %q, response header = %q, want req-1", requestID, recorder.Header().Get(HeaderRequestID))
+			}
+		})
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		keep   bool
+	}{
+		{name: "client id", header: "3f2a-req", keep: true},
+		{name: "empty", header: ""},
+		{name: "with space", header: "a b"},
+		{name: "control character", header: "a\nb"},
+		{name: "too long", header: string(make([]byte, maxRequestIDLength+1))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RequestID(tt.header)
+			if (got == tt.header) != tt.keep {
+				t.Errorf("RequestID(%q) = %q, keep %v", tt.header, got, tt.keep)
+			}
+			if !tt.keep && len(got) != 32 {
+				t.Errorf("generated id %q, want 32 hex characters", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Package tracing подключает сервис к трассировке OpenTelemetry: операции запросов
+// передаются между сервисами по W3C Trace Context (заголовок traceparent) и отправляются
+// в OTLP коллектор. Идентификатор запроса (X-Request-ID) ведётся отдельно от трассировки.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// HeaderRequestID - заголовок, по которому передаётся идентификатор запроса
+const HeaderRequestID = "X-Request-ID"
+
+// maxRequestIDLength ограничивает длину принятого от клиента X-Request-ID
+const maxRequestIDLength = 128
+
+// shutdownTimeout ограничивает отправку накопленных операций при остановке сервиса
+const shutdownTimeout = 5 * time.Second
+
+// Setup настраивает трассировку сервиса serviceName и делает её глобальной для OpenTelemetry.
+// Если задан endpoint (OTLP/HTTP коллектор, например http://localhost:4318), завершённые операции
+// отправляются в него пакетами в фоне; без коллектора операции не экспортируются, но идентификаторы
+// по-прежнему создаются и передаются дальше. Возвращает функцию остановки, которая досылает
+// накопленные операции.
+func Setup(serviceName, endpoint string) (func() error, error) {
+	options := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	}
+	if endpoint != "" {
+		exporter, err := otlptracehttp.New(context.Background(),
+			otlptracehttp.WithEndpointURL(strings.TrimRight(endpoint, "/")+"/v1/traces"))
+		if err != nil {
+			return nil, fmt.Errorf("tracing: некорректный адрес коллектора: %w", err)
+		}
+		options = append(options, sdktrace.WithBatcher(exporter))
+	}
+
+	provider := sdktrace.NewTracerProvider(options...)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		return provider.Shutdown(ctx)
+	}, nil
+}
+
+// requestIDKey - ключ идентификатора запроса в context.Context
+type requestIDKey struct{}
+
+// ContextWithRequestID сохраняет в ctx идентификатор запроса
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext возвращает идентификатор запроса из ctx или пустую строку
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// RequestID возвращает принятый от клиента X-Request-ID, если он допустим, иначе создаёт новый.
+// Допускаются только печатные ASCII символы без пробелов, чтобы идентификатор был безопасен для журналов.
+func RequestID(header string) string {
+	if header != "" && len(header) <= maxRequestIDLength {
+		valid := true
+		for i := 0; i < len(header); i++ {
+			if header[i] <= ' ' || header[i] > '~' {
+				valid = false
+				break
+			}
+		}
+		if valid {
+			return header
+		}
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		panic(fmt.Sprintf("tracing: не удалось получить случайные байты: %v", err))
+	}
+	return hex.EncodeToString(id)
+}
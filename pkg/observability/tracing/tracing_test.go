@@ -0,0 +1,69 @@
+package tracing
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSetupExportsToCollector(t *testing.T) {
+	requests := make(chan *coltracepb.ExportTraceServiceRequest, 1)
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/traces" {
+			t.Errorf("collector got %s %s, want POST /v1/traces", r.Method, r.URL.Path)
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		var request coltracepb.ExportTraceServiceRequest
+		if err := proto.Unmarshal(body, &request); err != nil {
+			t.Errorf("payload is not an OTLP export request: %v", err)
+		}
+		requests <- &request
+		w.Header().Set("Content-Type", "application/x-protobuf")
+	}))
+	defer collector.Close()
+
+	shutdown, err := Setup("test-service", collector.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, span := otel.Tracer("test").Start(context.Background(), "GET /ping")
+	traceID := span.SpanContext().TraceID()
+	span.End()
+	// Остановка досылает накопленные операции
+	if err := shutdown(); err != nil {
+		t.Fatal(err)
+	}
+
+	request := <-requests
+	if len(request.ResourceSpans) != 1 {
+		t.Fatalf("resource spans = %d, want 1", len(request.ResourceSpans))
+	}
+	resourceSpans := request.ResourceSpans[0]
+
+	serviceName := ""
+	for _, attr := range resourceSpans.Resource.Attributes {
+		if attr.Key == "service.name" {
+			serviceName = attr.Value.GetStringValue()
+		}
+	}
+	if serviceName != "test-service" {
+		t.Errorf("service.name = %q, want test-service", serviceName)
+	}
+
+	if len(resourceSpans.ScopeSpans) != 1 || len(resourceSpans.ScopeSpans[0].Spans) != 1 {
+		t.Fatalf("scope spans = %v, want one span", resourceSpans.ScopeSpans)
+	}
+	exported := resourceSpans.ScopeSpans[0].Spans[0]
+	if exported.Name != "GET /ping" || string(exported.TraceId) != string(traceID[:]) {
+		t.Errorf("span = %q trace %x, want %q trace %s", exported.Name, exported.TraceId, "GET /ping", traceID)
+	}
+}
@@ -3,7 +3,11 @@ package main
 import (
 	"api-gateway/internal/config"
 	"api-gateway/internal/router"
-	"log"
+	"log/slog"
+	"observability/logging"
+	"observability/metrics"
+	"observability/server"
+	"observability/tracing"
 
 	"github.com/gin-gonic/gin"
 )
@@ -13,8 +17,54 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
-	r := gin.Default()
-	router.SetupRoutes(r, cfg)
-	log.Printf("Starting server at port %d...", cfg.Port)
-	r.Run("localhost:8080")
+
+	// Структурированный журнал (JSON) с маскированием персональных данных и токенов
+	logging.Setup(cfg.LogLevel)
+
+	// Трассировка запросов; операции отправляются в OTLP коллектор, если он задан
+	shutdownTracing, err := tracing.Setup("api-gateway", cfg.OTLPEndpoint)
+	if err != nil {
+		logging.Fatal("Failed to set up tracing", "error", err)
+	}
+	if cfg.OTLPEndpoint != "" {
+		slog.Info("Exporting traces", "endpoint", cfg.OTLPEndpoint)
+	}
+
+	// Трассировка (до журнала, чтобы запись о запросе получила её идентификаторы, и до Recovery,
+	// чтобы операция завершилась и при панике обработчика), журнал запросов и восстановление после паники
+	r := gin.New()
+	r.Use(tracing.Middleware("api-gateway")...)
+	r.Use(logging.Middleware(), gin.Recovery())
+
+	// IP клиента (лимиты частоты запросов, журнал) берётся из X-Forwarded-For только от доверенных
+	// прокси: иначе клиент обходил бы лимиты, подставляя новый адрес в каждом запросе
+	if err := r.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		logging.Fatal("Invalid TRUSTED_PROXIES", "error", err)
+	}
+	readiness := server.NewReadiness()
+	router.SetupRoutes(r, cfg, readiness)
+
+	// Метрики отдаются на отдельном внутреннем порту: на публичном порту они раскрыли бы
+	// маршруты, объём трафика и ошибки любому клиенту
+	closeMetrics, err := metrics.Serve(":" + cfg.MetricsPort)
+	if err != nil {
+		logging.Fatal("Failed to start metrics server", "error", err)
+	}
+	slog.Info("Serving metrics", "port", cfg.MetricsPort)
+
+	// Запуск до SIGINT/SIGTERM, затем дожидаемся проксируемых запросов
+	slog.Info("Starting server", "port", cfg.Port)
+	err = server.Run(r, server.Config{
+		Addr:              ":" + cfg.Port,
+		ReadTimeout:       cfg.ServerReadTimeout,
+		ReadHeaderTimeout: cfg.ServerReadHeaderTimeout,
+		WriteTimeout:      cfg.ServerWriteTimeout,
+		IdleTimeout:       cfg.ServerIdleTimeout,
+		ShutdownDelay:     cfg.ShutdownDelay,
+		ShutdownTimeout:   cfg.ShutdownTimeout,
+	}, readiness, closeMetrics, shutdownTracing)
+	if err != nil {
+		logging.Fatal("Server error", "error", err)
+	}
+	slog.Info("Server stopped")
 }
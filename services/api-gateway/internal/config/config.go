@@ -1,8 +1,12 @@
 package config
 
 import (
-	"log"
+	"log/slog"
 	"os"
+	"strings"
+	"time"
+
+	"api-gateway/internal/ratelimit"
 
 	"github.com/joho/godotenv"
 )
@@ -12,30 +16,84 @@ type Config struct {
 	AuthServiceUrl     string
 	StudentServiceUrl  string
 	EmployerServiceUrl string
-	JWTSecret          string
+	VacancyServiceUrl  string
+
+	// Таймауты HTTP сервера и его остановки (остановка по умолчанию укладывается в 10s Docker)
+	ServerReadTimeout       time.Duration
+	ServerReadHeaderTimeout time.Duration
+	ServerWriteTimeout      time.Duration
+	ServerIdleTimeout       time.Duration
+	ShutdownDelay           time.Duration // Пауза после снятия готовности перед остановкой
+	ShutdownTimeout         time.Duration // Время на завершение проксируемых запросов
+
+	// Ограничение опроса каждого сервиса в /health/ready
+	HealthCheckTimeout time.Duration
+
+	// Адреса и подсети прокси перед gateway (балансировщик), которым доверяется X-Forwarded-For.
+	// По умолчанию не доверяется никому: IP клиента - адрес соединения
+	TrustedProxies []string
+
+	// Уровень журнала: debug, info, warn или error
+	LogLevel slog.Level
+
+	// Адрес OTLP/HTTP коллектора трассировок (например, http://localhost:4318; пусто - не отправлять)
+	OTLPEndpoint string
+
+	// Внутренний порт /metrics; в отличие от PORT не публикуется наружу
+	MetricsPort string
+
+	// Открытые ключи auth-service для проверки подписи токенов
+	JWKSURL      string
+	JWKSCacheTTL time.Duration
+
+	// Проверка отзыва access токенов
+	RevocationBackend  string        // "auth" - спрашивать auth-service, "none" - отключить
+	RevocationCacheTTL time.Duration // Задержка, с которой вступают в силу выход и деактивация
+
+	// Ограничение частоты запросов ("<запросов>/<период>")
+	RateLimitBackend string // "memory" - в памяти gateway, "none" - отключить
+	RateLimitLogin   ratelimit.Limit
+	RateLimitAuth    ratelimit.Limit
+	RateLimitRead    ratelimit.Limit
+	RateLimitWrite   ratelimit.Limit
 }
 
 func LoadConfig() (*Config, error) {
 	// Загружаем .env (игнорируем ошибку если файла нет)
 	err := godotenv.Load()
 	if err != nil {
-		log.Println("Warning: .env file not found, using environment variables")
-	}
-
-	// Читаем с значениями по умолчанию
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080" // Значение по умолчанию
+		slog.Warn(".env file not found, using environment variables")
 	}
 
 	config := &Config{
-		Port:               getEnvOrDefault("PORT", "http://localhost:8080"),
-		AuthServiceUrl:     getEnvOrDefault("AUTH_SERVICE_URL", "http://localhost:8081"),
-		StudentServiceUrl:  getEnvOrDefault("STUDENT_SERVICE_URL", "http://localhost:8082"),
-		EmployerServiceUrl: getEnvOrDefault("EMPLOYER_SERVICE_URL", "http://localhost:8083"),
-		JWTSecret:          os.Getenv("JWT_SECRET"),
+		Port:                    getEnvOrDefault("PORT", "8080"),
+		AuthServiceUrl:          getEnvOrDefault("AUTH_SERVICE_URL", "http://localhost:8081"),
+		StudentServiceUrl:       getEnvOrDefault("STUDENT_SERVICE_URL", "http://localhost:8082"),
+		EmployerServiceUrl:      getEnvOrDefault("EMPLOYER_SERVICE_URL", "http://localhost:8083"),
+		VacancyServiceUrl:       getEnvOrDefault("VACANCY_SERVICE_URL", "http://localhost:8084"),
+		ServerReadTimeout:       getDurationOrDefault("SERVER_READ_TIMEOUT", 15*time.Second),
+		ServerReadHeaderTimeout: getDurationOrDefault("SERVER_READ_HEADER_TIMEOUT", 5*time.Second),
+		ServerWriteTimeout:      getDurationOrDefault("SERVER_WRITE_TIMEOUT", 30*time.Second),
+		ServerIdleTimeout:       getDurationOrDefault("SERVER_IDLE_TIMEOUT", 60*time.Second),
+		ShutdownDelay:           getDurationOrDefault("SHUTDOWN_DELAY", 2*time.Second),
+		ShutdownTimeout:         getDurationOrDefault("SHUTDOWN_TIMEOUT", 7*time.Second),
+		RevocationBackend:       getEnvOrDefault("REVOCATION_BACKEND", "auth"),
+		RevocationCacheTTL:      getDurationOrDefault("REVOCATION_CACHE_TTL", 5*time.Second),
+		RateLimitBackend:        getEnvOrDefault("RATE_LIMIT_BACKEND", "memory"),
+		RateLimitLogin:          getLimitOrDefault("RATE_LIMIT_LOGIN", "10/1m"),
+		RateLimitAuth:           getLimitOrDefault("RATE_LIMIT_AUTH", "60/1m"),
+		RateLimitRead:           getLimitOrDefault("RATE_LIMIT_READ", "300/1m"),
+		RateLimitWrite:          getLimitOrDefault("RATE_LIMIT_WRITE", "60/1m"),
 	}
 
+	config.JWKSURL = getEnvOrDefault("JWKS_URL", config.AuthServiceUrl+"/.well-known/jwks.json")
+	config.JWKSCacheTTL = getDurationOrDefault("JWKS_CACHE_TTL", 5*time.Minute)
+	config.HealthCheckTimeout = getDurationOrDefault("HEALTH_CHECK_TIMEOUT", 2*time.Second)
+	config.OTLPEndpoint = getEnvOrDefault("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+	config.MetricsPort = getEnvOrDefault("METRICS_PORT", "9090")
+	config.LogLevel = getLevelOrDefault("LOG_LEVEL", slog.LevelInfo)
+	config.TrustedProxies = getListOrDefault("TRUSTED_PROXIES", nil)
+
 	return config, nil
 }
 
@@ -47,3 +105,46 @@ func getEnvOrDefault(key, defaultValue string) string {
 	}
 	return value
 }
+
+// Вспомогательная функция для длительностей ("5s", "1m")
+func getDurationOrDefault(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+// Вспомогательная функция для лимитов частоты запросов ("10/1m")
+func getLimitOrDefault(key, defaultValue string) ratelimit.Limit {
+	limit, err := ratelimit.ParseLimit(getEnvOrDefault(key, defaultValue))
+	if err != nil {
+		slog.Warn("Invalid rate limit, using default", "key", key, "error", err, "default", defaultValue)
+		limit, _ = ratelimit.ParseLimit(defaultValue)
+	}
+	return limit
+}
+
+// Вспомогательная функция для уровня журнала ("debug", "info", "warn", "error")
+func getLevelOrDefault(key string, defaultValue slog.Level) slog.Level {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(getEnvOrDefault(key, defaultValue.String()))); err != nil {
+		slog.Warn("Invalid log level, using default", "key", key, "error", err, "default", defaultValue.String())
+		return defaultValue
+	}
+	return level
+}
+
+// Вспомогательная функция для списков через запятую ("10.0.0.0/8,192.168.1.10")
+func getListOrDefault(key string, defaultValue []string) []string {
+	var items []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return defaultValue
+	}
+	return items
+}
@@ -0,0 +1,8 @@
+package dto
+
+// ErrorResponse - ответ с ошибкой в том же формате, что и у микросервисов
+type ErrorResponse struct {
+	Error   string            `json:"error"`
+	Message string            `json:"message"`
+	Details map[string]string `json:"details,omitempty"`
+}
@@ -0,0 +1,101 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Состояния сервиса
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Upstream - сервис за gateway, готовность которого проверяется
+type Upstream struct {
+	Name string
+	URL  string
+}
+
+// Component - состояние сервиса в ответе /health/ready
+type Component struct {
+	Status     string `json:"status"`
+	LatencyMs  int64  `json:"latency_ms"`
+	HTTPStatus int    `json:"http_status,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Checker - опрашивает /health/ready сервисов за gateway
+type Checker struct {
+	upstreams  []Upstream
+	httpClient *http.Client
+}
+
+// NewChecker - создаёт проверку готовности сервисов с ограничением timeout на каждый запрос
+func NewChecker(upstreams []Upstream, timeout time.Duration) *Checker {
+	return &Checker{
+		upstreams:  upstreams,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// Check - опрашивает все сервисы параллельно; ready - все сервисы готовы
+func (c *Checker) Check(ctx context.Context) (components map[string]Component, ready bool) {
+	components = make(map[string]Component, len(c.upstreams))
+	ready = true
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, upstream := range c.upstreams {
+		wg.Add(1)
+		go func(upstream Upstream) {
+			defer wg.Done()
+			component := c.checkUpstream(ctx, upstream)
+
+			mu.Lock()
+			defer mu.Unlock()
+			components[upstream.Name] = component
+			if component.Status != StatusUp {
+				ready = false
+			}
+		}(upstream)
+	}
+	wg.Wait()
+
+	return components, ready
+}
+
+// checkUpstream - запрашивает GET <url>/health/ready; готов сервис, ответивший 200
+func (c *Checker) checkUpstream(ctx context.Context, upstream Upstream) Component {
+	started := time.Now()
+	component := Component{Status: StatusDown}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(upstream.URL, "/")+"/health/ready", nil)
+	if err != nil {
+		component.Error = err.Error()
+		return component
+	}
+
+	resp, err := c.httpClient.Do(req)
+	component.LatencyMs = time.Since(started).Milliseconds()
+	if err != nil {
+		component.Error = err.Error()
+		return component
+	}
+	resp.Body.Close()
+
+	component.HTTPStatus = resp.StatusCode
+	if resp.StatusCode != http.StatusOK {
+		component.Error = fmt.Sprintf("unexpected status %d", resp.StatusCode)
+		return component
+	}
+
+	component.Status = StatusUp
+	return component
+}
@@ -0,0 +1,182 @@
+package jwks
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Ошибки получения ключей
+var (
+	ErrUnavailable = errors.New("jwks unavailable")
+	ErrUnknownKey  = errors.New("unknown signing key")
+)
+
+// minRefreshInterval - не чаще этого интервала JWKS перезапрашивается из-за неизвестного kid
+// (защита auth-service от потока токенов с выдуманными kid)
+const minRefreshInterval = 10 * time.Second
+
+// Key - открытый ключ проверки подписи и его алгоритм
+type Key struct {
+	Algorithm string // RS256 или EdDSA
+	PublicKey crypto.PublicKey
+}
+
+// jwk - ключ в формате JSON Web Key (RFC 7517)
+type jwk struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+}
+
+// Cache - загружает открытые ключи auth-service и хранит их ttl.
+// Неизвестный kid (ротация ключей) приводит к внеочередной загрузке.
+// Если auth-service недоступен, используются ранее загруженные ключи.
+type Cache struct {
+	url        string
+	ttl        time.Duration
+	httpClient *http.Client
+
+	mu          sync.RWMutex
+	keys        map[string]Key
+	fetchedAt   time.Time
+	lastAttempt time.Time
+}
+
+// NewCache - создаёт кэш JWKS, загружаемого по адресу url
+func NewCache(url string, ttl time.Duration) *Cache {
+	return &Cache{
+		url:        url,
+		ttl:        ttl,
+		httpClient: &http.Client{Timeout: 3 * time.Second},
+		keys:       make(map[string]Key),
+	}
+}
+
+// Key - возвращает ключ с идентификатором kid
+func (c *Cache) Key(ctx context.Context, kid string) (Key, error) {
+	// 1. Свежий набор ключей, в котором есть kid
+	c.mu.RLock()
+	key, ok := c.keys[kid]
+	fresh := time.Since(c.fetchedAt) < c.ttl
+	c.mu.RUnlock()
+	if ok && fresh {
+		return key, nil
+	}
+
+	// 2. Перезагружаем набор (устарел или kid неизвестен)
+	if err := c.refresh(ctx); err != nil && !ok {
+		return Key{}, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if key, ok := c.keys[kid]; ok {
+		return key, nil
+	}
+	return Key{}, ErrUnknownKey
+}
+
+// refresh - загружает JWKS (не чаще minRefreshInterval)
+func (c *Cache) refresh(ctx context.Context) error {
+	c.mu.Lock()
+	if time.Since(c.lastAttempt) < minRefreshInterval {
+		c.mu.Unlock()
+		return nil
+	}
+	c.lastAttempt = time.Now()
+	c.mu.Unlock()
+
+	keys, err := c.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.keys = keys
+	c.fetchedAt = time.Now()
+	c.mu.Unlock()
+	return nil
+}
+
+// fetch - запрашивает и разбирает JWKS
+func (c *Cache) fetch(ctx context.Context) (map[string]Key, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: status %d", ErrUnavailable, resp.StatusCode)
+	}
+
+	var body struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+
+	keys := make(map[string]Key, len(body.Keys))
+	for _, k := range body.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.parse()
+		if err != nil {
+			// Ключ неподдерживаемого типа пропускается, остальные остаются рабочими
+			continue
+		}
+		keys[k.KeyID] = key
+	}
+	return keys, nil
+}
+
+// parse - преобразует JWK в открытый ключ
+func (k jwk) parse() (Key, error) {
+	switch {
+	case k.KeyType == "OKP" && k.Curve == "Ed25519":
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return Key{}, fmt.Errorf("invalid Ed25519 key %q", k.KeyID)
+		}
+		return Key{Algorithm: "EdDSA", PublicKey: ed25519.PublicKey(x)}, nil
+
+	case k.KeyType == "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return Key{}, fmt.Errorf("invalid RSA modulus in key %q", k.KeyID)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return Key{}, fmt.Errorf("invalid RSA exponent in key %q", k.KeyID)
+		}
+		return Key{Algorithm: "RS256", PublicKey: &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}}, nil
+
+	default:
+		return Key{}, fmt.Errorf("unsupported key type %q", k.KeyType)
+	}
+}
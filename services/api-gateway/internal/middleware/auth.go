@@ -1,14 +1,20 @@
 package middleware
 
 import (
+	"api-gateway/internal/jwks"
+	"api-gateway/internal/revocation"
+	"errors"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 )
 
-// AuthMiddleware - проверяет JWT токен
-func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
+// AuthMiddleware - проверяет подпись JWT токена по открытым ключам auth-service (JWKS),
+// что это access токен сеанса и (если checker задан) что он не отозван.
+// Остальные токены auth-service (refresh, mfa_challenge, email_verification) подписаны тем же ключом,
+// но доступа к API не дают: иначе, например, токен после ввода пароля обходил бы второй фактор.
+func AuthMiddleware(keys *jwks.Cache, checker *revocation.Checker) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 1. Получаем header Authorization
 		authHeader := c.GetHeader("Authorization")
@@ -30,15 +36,25 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 		// 4. Извлекаем токен (убираем "Bearer ")
 		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 
-		// 5. Парсим и проверяем JWT токен
+		// 5. Парсим и проверяем JWT токен: ключ выбирается по kid, алгоритм задаёт ключ
 		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			kid, _ := token.Header["kid"].(string)
+			key, err := keys.Key(c.Request.Context(), kid)
+			if err != nil {
+				return nil, err
+			}
+			if token.Method.Alg() != key.Algorithm {
 				return nil, jwt.ErrSignatureInvalid
 			}
-			return []byte(jwtSecret), nil
-		})
+			return key.PublicKey, nil
+		}, jwt.WithValidMethods([]string{"RS256", "EdDSA"}))
 
-		// 6. Проверяем на ошибки
+		// 6. Проверяем на ошибки (без ключей auth-service проверить подпись нельзя)
+		if errors.Is(err, jwks.ErrUnavailable) {
+			c.JSON(503, gin.H{"error": "Auth service unavailable"})
+			c.Abort()
+			return
+		}
 		if err != nil || !token.Valid {
 			c.JSON(401, gin.H{"error": "Invalid token"})
 			c.Abort()
@@ -53,7 +69,20 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 			return
 		}
 
-		// 8. Достаём user_id из токена
+		// 8. Принимаем только access токены, выданные в рамках сеанса
+		if tokenType, _ := claims["token_type"].(string); tokenType != "access" {
+			c.JSON(401, gin.H{"error": "Invalid token type"})
+			c.Abort()
+			return
+		}
+		sessionID, _ := claims["sid"].(string)
+		if sessionID == "" {
+			c.JSON(401, gin.H{"error": "Session not found in token"})
+			c.Abort()
+			return
+		}
+
+		// 9. Достаём user_id из токена
 		userID, ok := claims["user_id"].(string)
 		if !ok {
 			c.JSON(401, gin.H{"error": "User ID not found in token"})
@@ -61,13 +90,39 @@ func AuthMiddleware(jwtSecret string) gin.HandlerFunc {
 			return
 		}
 
-		// 9. Добавляем user_id в header для микросервисов
+		// 10. Достаём роль и email (нужны микросервисам для проверки прав)
+		role, _ := claims["role"].(string)
+		email, _ := claims["email"].(string)
+		emailVerified, _ := claims["email_verified"].(bool)
+
+		// 11. Проверяем отзыв: выход из системы и деактивация учётной записи
+		if checker != nil {
+			status, err := checker.Check(c.Request.Context(), userID, sessionID)
+			if err != nil {
+				c.JSON(503, gin.H{"error": "Auth service unavailable"})
+				c.Abort()
+				return
+			}
+			if !status.Active {
+				c.JSON(401, gin.H{"error": "Token revoked"})
+				c.Abort()
+				return
+			}
+		}
+
+		// 12. Добавляем user_id, роль и email в headers для микросервисов
+		// (Set перезаписывает значения, присланные клиентом)
 		c.Request.Header.Set("X-User-ID", userID)
+		c.Request.Header.Set("X-User-Role", role)
+		c.Request.Header.Set("X-User-Email", email)
 
-		// 10. Сохраняем в контекст Gin
+		// 13. Сохраняем в контекст Gin
 		c.Set("user_id", userID)
+		c.Set("user_role", role)
+		c.Set("user_email", email)
+		c.Set("email_verified", emailVerified)
 
-		// 11. Продолжаем обработку
+		// 14. Продолжаем обработку
 		c.Next()
 	}
 }
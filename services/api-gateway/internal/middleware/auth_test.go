@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"api-gateway/internal/jwks"
+	"api-gateway/internal/revocation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// activeBackend - сведения об отзыве: все сеансы активны
+type activeBackend struct{}
+
+func (activeBackend) Status(context.Context, string, string) (revocation.Status, error) {
+	return revocation.Status{Active: true}, nil
+}
+
+// newTestKeys - ключ подписи и JWKS сервер с его открытой частью
+func newTestKeys(t *testing.T) (ed25519.PrivateKey, *jwks.Cache) {
+	t.Helper()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "OKP",
+			"crv": "Ed25519",
+			"kid": "test",
+			"alg": "EdDSA",
+			"use": "sig",
+			"x":   base64.RawURLEncoding.EncodeToString(public),
+		}}})
+	}))
+	t.Cleanup(server.Close)
+
+	return private, jwks.NewCache(server.URL, time.Minute)
+}
+
+// signToken - подписывает токен с claims как у auth-service
+func signToken(t *testing.T, key ed25519.PrivateKey, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = "test"
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestAuthMiddlewareTokenTypes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	key, keys := newTestKeys(t)
+
+	claims := func(tokenType, sessionID string) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"user_id":        "550e8400-e29b-41d4-a716-446655440000",
+			"email":          "student@example.com",
+			"role":           "student",
+			"email_verified": true,
+			"exp":            time.Now().Add(time.Hour).Unix(),
+		}
+		if tokenType != "" {
+			c["token_type"] = tokenType
+		}
+		if sessionID != "" {
+			c["sid"] = sessionID
+		}
+		return c
+	}
+
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+		want   int
+	}{
+		{"access token of a session", claims("access", "7c9e6679-7425-40de-944b-e07fc1f90ae7"), http.StatusOK},
+		{"access token without session", claims("access", ""), http.StatusUnauthorized},
+		{"refresh token", claims("refresh", "7c9e6679-7425-40de-944b-e07fc1f90ae7"), http.StatusUnauthorized},
+		{"mfa challenge token", claims("mfa_challenge", ""), http.StatusUnauthorized},
+		{"email verification token", claims("email_verification", ""), http.StatusUnauthorized},
+		{"token without type", claims("", "7c9e6679-7425-40de-944b-e07fc1f90ae7"), http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.GET("/", AuthMiddleware(keys, revocation.NewChecker(activeBackend{}, time.Minute)), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer "+signToken(t, key, tt.claims))
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d (body %s)", w.Code, tt.want, w.Body.String())
+			}
+		})
+	}
+}
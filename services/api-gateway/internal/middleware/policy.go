@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"api-gateway/internal/dto"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Rule - правило доступа: запросы с методом Method к пути Path разрешены только ролям Roles.
+// В Path сегмент ":name" совпадает с любым одним сегментом, а "*" в конце - с любым остатком пути.
+// Method "*" совпадает с любым методом.
+type Rule struct {
+	Method string
+	Path   string
+	Roles  []string
+}
+
+// Policy - упорядоченный список правил; применяется первое совпавшее.
+// Запросы, не попавшие ни под одно правило, доступны любому аутентифицированному пользователю.
+type Policy []Rule
+
+// Allowed - проверяет, может ли роль выполнить запрос
+func (p Policy) Allowed(method, path, role string) bool {
+	for _, rule := range p {
+		if !rule.matches(method, path) {
+			continue
+		}
+		for _, allowed := range rule.Roles {
+			if role == allowed {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// matches - проверяет, подпадает ли запрос под правило
+func (r Rule) matches(method, path string) bool {
+	if r.Method != "*" && !strings.EqualFold(r.Method, method) {
+		return false
+	}
+
+	pattern := strings.Split(strings.Trim(r.Path, "/"), "/")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for i, part := range pattern {
+		if part == "*" && i == len(pattern)-1 {
+			return true
+		}
+		if i >= len(segments) {
+			return false
+		}
+		if !strings.HasPrefix(part, ":") && part != segments[i] {
+			return false
+		}
+	}
+	return len(pattern) == len(segments)
+}
+
+// RoleMiddleware - проверяет роль пользователя по политике доступа.
+// Должен стоять после AuthMiddleware, который кладёт роль в контекст.
+func RoleMiddleware(policy Policy) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := c.GetString("user_role")
+
+		if !policy.Allowed(c.Request.Method, c.Request.URL.Path, role) {
+			c.AbortWithStatusJSON(403, dto.ErrorResponse{
+				Error:   "Доступ запрещён",
+				Message: "Недостаточно прав для выполнения операции",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
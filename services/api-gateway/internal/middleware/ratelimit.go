@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"api-gateway/internal/dto"
+	"api-gateway/internal/ratelimit"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimitKey - чем различаются клиенты внутри группы лимитов
+type RateLimitKey int
+
+const (
+	// KeyByIP - лимит на IP-адрес клиента
+	KeyByIP RateLimitKey = iota
+	// KeyByUser - лимит на пользователя (X-User-ID после AuthMiddleware), без аутентификации - на IP-адрес
+	KeyByUser
+)
+
+// Route - маршрут в формате правил политики доступа (Method и Path как в Rule)
+type Route struct {
+	Method string
+	Path   string
+}
+
+// RateLimitGroup - группа маршрутов с общим лимитом
+type RateLimitGroup struct {
+	Name   string
+	Limit  ratelimit.Limit
+	Key    RateLimitKey
+	Routes []Route
+}
+
+// RateLimits - упорядоченный список групп; применяется первая группа, под маршрут которой попал запрос.
+// Запросы вне всех групп не ограничиваются.
+type RateLimits []RateLimitGroup
+
+// group - находит группу лимитов для запроса
+func (l RateLimits) group(method, path string) (RateLimitGroup, bool) {
+	for _, group := range l {
+		for _, route := range group.Routes {
+			if (Rule{Method: route.Method, Path: route.Path}).matches(method, path) {
+				return group, true
+			}
+		}
+	}
+	return RateLimitGroup{}, false
+}
+
+// RateLimitMiddleware - ограничивает частоту запросов по алгоритму token bucket.
+// Для групп с KeyByUser должен стоять после AuthMiddleware.
+func RateLimitMiddleware(store ratelimit.Store, limits RateLimits) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 1. Ищем группу лимитов для маршрута
+		group, ok := limits.group(c.Request.Method, c.Request.URL.Path)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		// 2. Определяем клиента
+		client := "ip:" + c.ClientIP()
+		if userID := c.GetString("user_id"); group.Key == KeyByUser && userID != "" {
+			client = "user:" + userID
+		}
+
+		// 3. Забираем токен (при недоступном хранилище запрос пропускается)
+		result, err := store.Take(c.Request.Context(), group.Name+":"+client, group.Limit)
+		if err != nil {
+			slog.WarnContext(c.Request.Context(), "Rate limit store unavailable", "error", err)
+			c.Next()
+			return
+		}
+
+		// 4. Сообщаем клиенту состояние лимита
+		c.Header("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("X-RateLimit-Reset", strconv.Itoa(ceilSeconds(result.ResetAfter)))
+
+		// 5. Лимит исчерпан
+		if !result.Allowed {
+			retryAfter := ceilSeconds(result.RetryAfter)
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+			c.AbortWithStatusJSON(429, dto.ErrorResponse{
+				Error:   "Слишком много запросов",
+				Message: fmt.Sprintf("Превышен лимит запросов, повторите через %d с", retryAfter),
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// ceilSeconds - длительность в целых секундах с округлением вверх
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"api-gateway/internal/dto"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VerifiedEmailMiddleware - до подтверждения email пользователю доступны только операции чтения.
+// Должен стоять после AuthMiddleware. Признак берётся из claim email_verified:
+// после подтверждения клиент обновляет токены через /auth/refresh.
+func VerifiedEmailMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case "GET", "HEAD", "OPTIONS":
+			c.Next()
+			return
+		}
+
+		if !c.GetBool("email_verified") {
+			c.AbortWithStatusJSON(403, dto.ErrorResponse{
+				Error:   "Доступ запрещён",
+				Message: "Подтвердите email, чтобы выполнить это действие",
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -4,8 +4,34 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"observability/tracing"
+)
+
+// tracerName - имя инструментирования операций обращения к сервисам
+const tracerName = "api-gateway/proxy"
+
+// Метрики обращений к сервисам за gateway (метка target - адрес сервиса)
+var (
+	upstreamDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "gateway_upstream_request_duration_seconds",
+		Help:    "Time until upstream response headers are received, by target.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"target"})
+	upstreamFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "gateway_upstream_failures_total",
+		Help: "Proxied requests answered with 502 because the upstream was unreachable, by target.",
+	}, []string{"target"})
 )
 
 // NewServiceProxy - создаёт прокси для перенаправления запросов
@@ -18,6 +44,12 @@ func NewServiceProxy(targetURL string) gin.HandlerFunc {
 			return
 		}
 
+		// Операция обращения к сервису - дочерняя для операции запроса к gateway
+		ctx, span := otel.Tracer(tracerName).Start(c.Request.Context(), "proxy "+target.Host,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attribute.String("peer.service", target.Host)))
+		defer span.End()
+
 		// 2. Создаём reverse proxy
 		proxy := httputil.NewSingleHostReverseProxy(target)
 
@@ -27,16 +59,46 @@ func NewServiceProxy(targetURL string) gin.HandlerFunc {
 			req.URL.Host = target.Host
 			req.Host = target.Host
 
+			// Идентификатор запроса и трассировка передаются сервису
+			if requestID := tracing.RequestIDFromContext(ctx); requestID != "" {
+				req.Header.Set(tracing.HeaderRequestID, requestID)
+			}
+			otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
 			// Path остаётся как есть!
 			// /api/auth/login → /api/auth/login
 		}
 
-		// 4. Обработка ошибок прокси
+		// 4. Замеряем время ответа сервиса
+		proxy.Transport = timedTransport{target: target.Host}
+		proxy.ModifyResponse = func(resp *http.Response) error {
+			span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+			return nil
+		}
+
+		// 5. Обработка ошибок прокси
 		proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+			upstreamFailures.WithLabelValues(target.Host).Inc()
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "upstream unavailable")
+			_ = c.Error(err) // причина попадёт в журнал запроса
 			c.JSON(502, gin.H{"error": "Service unavailable"})
 		}
 
-		// 5. Отправляем запрос через прокси
+		// 6. Отправляем запрос через прокси
 		proxy.ServeHTTP(c.Writer, c.Request)
 	}
 }
+
+// timedTransport - учитывает время до получения заголовков ответа сервиса
+type timedTransport struct {
+	target string
+}
+
+// RoundTrip - реализует http.RoundTripper поверх http.DefaultTransport
+func (t timedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	started := time.Now()
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	upstreamDuration.WithLabelValues(t.target).Observe(time.Since(started).Seconds())
+	return resp, err
+}
@@ -0,0 +1,117 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"observability/tracing"
+)
+
+// newTracedGateway - gateway с трассировкой, записывающей завершённые операции в память.
+// Gateway запускается на httptest.Server: ReverseProxy требует http.CloseNotifier,
+// которого нет у httptest.ResponseRecorder.
+func newTracedGateway(t *testing.T, targetURL string) (*httptest.Server, *tracetest.SpanRecorder) {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(tracing.Middleware("api-gateway")...)
+	r.Any("/api/*path", NewServiceProxy(targetURL))
+
+	gateway := httptest.NewServer(r)
+	t.Cleanup(gateway.Close)
+	return gateway, recorder
+}
+
+// proxySpan возвращает завершённую операцию обращения к сервису. Клиент может получить ответ
+// раньше, чем обработчик завершит операцию, поэтому сначала дожидаемся остановки gateway.
+func proxySpan(t *testing.T, gateway *httptest.Server, recorder *tracetest.SpanRecorder) sdktrace.ReadOnlySpan {
+	t.Helper()
+	gateway.Close()
+	for _, span := range recorder.Ended() {
+		if span.SpanKind() == trace.SpanKindClient {
+			return span
+		}
+	}
+	t.Fatal("no proxy span recorded")
+	return nil
+}
+
+func TestProxyPropagatesTrace(t *testing.T) {
+	upstreamHeaders := make(chan http.Header, 1)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upstreamHeaders <- r.Header.Clone()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer upstream.Close()
+
+	gateway, recorder := newTracedGateway(t, upstream.URL)
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	req, err := http.NewRequest(http.MethodGet, gateway.URL+"/api/auth/me", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	req.Header.Set(tracing.HeaderRequestID, "req-1")
+	response, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent {
+		t.Fatalf("status = %d, want %d", response.StatusCode, http.StatusNoContent)
+	}
+	headers := <-upstreamHeaders
+	if got := headers.Get(tracing.HeaderRequestID); got != "req-1" {
+		t.Errorf("upstream X-Request-ID = %q, want req-1", got)
+	}
+
+	// Сервис продолжает трассировку клиента, родитель его операции - операция обращения gateway
+	span := proxySpan(t, gateway, recorder)
+	propagated := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), propagation.HeaderCarrier(headers)))
+	if propagated.TraceID().String() != traceID {
+		t.Errorf("upstream trace id = %s, want %s", propagated.TraceID(), traceID)
+	}
+	if propagated.SpanID() != span.SpanContext().SpanID() {
+		t.Errorf("upstream parent span = %s, want proxy span %s", propagated.SpanID(), span.SpanContext().SpanID())
+	}
+	if !propagated.IsSampled() {
+		t.Error("upstream trace is not sampled, want sampled as the client's")
+	}
+}
+
+func TestProxyRecordsUpstreamFailure(t *testing.T) {
+	upstream := httptest.NewServer(http.NotFoundHandler())
+	upstream.Close() // адрес, на котором никто не слушает
+
+	gateway, recorder := newTracedGateway(t, upstream.URL)
+	response, err := http.Get(gateway.URL + "/api/auth/me")
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusBadGateway {
+		t.Errorf("status = %d, want %d", response.StatusCode, http.StatusBadGateway)
+	}
+	if span := proxySpan(t, gateway, recorder); span.Status().Code != codes.Error {
+		t.Errorf("proxy span status = %v, want error", span.Status())
+	}
+}
@@ -0,0 +1,56 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// maxBuckets - при превышении из памяти удаляются полностью восстановившиеся корзины
+const maxBuckets = 100000
+
+// MemoryStore - корзины в памяти процесса (лимиты считаются отдельно для каждого экземпляра gateway)
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*memoryBucket
+}
+
+// memoryBucket - корзина и период её полного восстановления (нужен для очистки)
+type memoryBucket struct {
+	bucket
+	period time.Duration
+}
+
+// NewMemoryStore - создаёт хранилище корзин в памяти
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{buckets: make(map[string]*memoryBucket)}
+}
+
+// Take - забирает токен из корзины key (новая корзина создаётся полной)
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		if len(s.buckets) >= maxBuckets {
+			s.evictIdle(now)
+		}
+		b = &memoryBucket{bucket: bucket{tokens: float64(limit.Burst), updated: now}}
+		s.buckets[key] = b
+	}
+	b.period = limit.Period
+
+	return b.take(limit, now), nil
+}
+
+// evictIdle - удаляет корзины, которые уже наполнились (вызывается под блокировкой)
+func (s *MemoryStore) evictIdle(now time.Time) {
+	for key, b := range s.buckets {
+		if now.Sub(b.updated) >= b.period {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit - параметры token bucket: не больше Burst запросов подряд,
+// корзина полностью восстанавливается за Period
+type Limit struct {
+	Burst  int
+	Period time.Duration
+}
+
+// ParseLimit - разбирает лимит в формате "<запросов>/<период>", например "5/1m" или "300/1m"
+func ParseLimit(value string) (Limit, error) {
+	count, period, ok := strings.Cut(value, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: expected <requests>/<period>", value)
+	}
+
+	burst, err := strconv.Atoi(strings.TrimSpace(count))
+	if err != nil || burst <= 0 {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: requests must be a positive number", value)
+	}
+	duration, err := time.ParseDuration(strings.TrimSpace(period))
+	if err != nil || duration <= 0 {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: period must be a positive duration", value)
+	}
+
+	return Limit{Burst: burst, Period: duration}, nil
+}
+
+// String - лимит в том же формате, что принимает ParseLimit
+func (l Limit) String() string {
+	return fmt.Sprintf("%d/%s", l.Burst, l.Period)
+}
+
+// rate - скорость пополнения корзины (токенов в секунду)
+func (l Limit) rate() float64 {
+	return float64(l.Burst) / l.Period.Seconds()
+}
+
+// Result - итог попытки взять токен
+type Result struct {
+	Allowed    bool
+	Limit      int           // Ёмкость корзины
+	Remaining  int           // Сколько запросов ещё можно сделать сразу
+	RetryAfter time.Duration // Через сколько появится токен (если запрос отклонён)
+	ResetAfter time.Duration // Через сколько корзина наполнится полностью
+}
+
+// Store - хранилище корзин.
+// Реализации: MemoryStore (в памяти процесса); для нескольких экземпляров gateway
+// можно подключить общее хранилище (Redis и т.п.), выполняющее Take атомарно.
+type Store interface {
+	// Take забирает один токен из корзины key
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// bucket - состояние одной корзины
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// take - пополняет корзину на момент now и пытается забрать токен
+func (b *bucket) take(limit Limit, now time.Time) Result {
+	rate := limit.rate()
+	capacity := float64(limit.Burst)
+
+	// 1. Пополняем корзину за прошедшее время
+	b.tokens += now.Sub(b.updated).Seconds() * rate
+	if b.tokens > capacity {
+		b.tokens = capacity
+	}
+	b.updated = now
+
+	// 2. Забираем токен, если он есть
+	result := Result{Limit: limit.Burst}
+	if b.tokens >= 1 {
+		b.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = seconds((1 - b.tokens) / rate)
+	}
+
+	result.Remaining = int(b.tokens)
+	result.ResetAfter = seconds((capacity - b.tokens) / rate)
+	return result
+}
+
+// seconds - переводит дробное число секунд в Duration
+func seconds(value float64) time.Duration {
+	return time.Duration(value * float64(time.Second))
+}
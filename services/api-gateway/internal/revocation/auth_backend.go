@@ -0,0 +1,64 @@
+package revocation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// AuthServiceBackend - получает состояние токенов из внутреннего эндпоинта auth-service
+type AuthServiceBackend struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// tokenStatusResponse - ответ GET /internal/tokens/status
+type tokenStatusResponse struct {
+	Active bool   `json:"active"`
+	Reason string `json:"reason"`
+}
+
+// NewAuthServiceBackend - создаёт backend поверх auth-service
+func NewAuthServiceBackend(authServiceURL string) *AuthServiceBackend {
+	return &AuthServiceBackend{
+		baseURL:    strings.TrimRight(authServiceURL, "/"),
+		httpClient: &http.Client{Timeout: 3 * time.Second},
+	}
+}
+
+// Status - реализует Backend
+func (b *AuthServiceBackend) Status(ctx context.Context, userID, sessionID string) (Status, error) {
+	query := url.Values{"user_id": {userID}}
+	if sessionID != "" {
+		query.Set("session_id", sessionID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.baseURL+"/internal/tokens/status?"+query.Encode(), nil)
+	if err != nil {
+		return Status{}, err
+	}
+
+	resp, err := b.httpClient.Do(req)
+	if err != nil {
+		return Status{}, fmt.Errorf("%w: %v", ErrBackendUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var body tokenStatusResponse
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return Status{}, fmt.Errorf("%w: %v", ErrBackendUnavailable, err)
+		}
+		return Status{Active: body.Active, Reason: body.Reason}, nil
+	case http.StatusBadRequest:
+		// Идентификаторы из токена не являются UUID - такой токен не принимаем
+		return Status{Active: false, Reason: "malformed_claims"}, nil
+	default:
+		return Status{}, fmt.Errorf("%w: status %d", ErrBackendUnavailable, resp.StatusCode)
+	}
+}
@@ -0,0 +1,91 @@
+package revocation
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrBackendUnavailable - хранилище сведений об отзыве недоступно
+var ErrBackendUnavailable = errors.New("revocation backend unavailable")
+
+// maxCacheEntries - при превышении из кэша удаляются устаревшие записи
+const maxCacheEntries = 10000
+
+// Status - состояние access токена
+type Status struct {
+	Active bool   // Токен можно принимать
+	Reason string // Причина отзыва (user_inactive, session_revoked, ...)
+}
+
+// Backend - источник сведений об отзыве токенов.
+// Реализации: AuthServiceBackend (запрос в auth-service); можно подключить общее хранилище (Redis и т.п.)
+type Backend interface {
+	// Status возвращает состояние токена пользователя в рамках сеанса (sessionID может быть пустым)
+	Status(ctx context.Context, userID, sessionID string) (Status, error)
+}
+
+// cacheEntry - закэшированный ответ backend
+type cacheEntry struct {
+	status    Status
+	expiresAt time.Time
+}
+
+// Checker - проверяет отзыв токенов с локальным кэшем поверх Backend.
+// TTL кэша определяет, через сколько секунд вступает в силу выход или деактивация.
+type Checker struct {
+	backend Backend
+	ttl     time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+// NewChecker - создаёт проверку отзыва с кэшем на ttl
+func NewChecker(backend Backend, ttl time.Duration) *Checker {
+	return &Checker{
+		backend: backend,
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// Check - возвращает состояние токена, обращаясь к backend не чаще раза в ttl
+func (c *Checker) Check(ctx context.Context, userID, sessionID string) (Status, error) {
+	key := userID + ":" + sessionID
+	now := time.Now()
+
+	// 1. Ищем свежий ответ в кэше
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.status, nil
+	}
+
+	// 2. Спрашиваем backend (ошибки не кэшируются)
+	status, err := c.backend.Status(ctx, userID, sessionID)
+	if err != nil {
+		return Status{}, err
+	}
+
+	// 3. Сохраняем ответ
+	c.mu.Lock()
+	if len(c.entries) >= maxCacheEntries {
+		c.evictExpired(now)
+	}
+	c.entries[key] = cacheEntry{status: status, expiresAt: now.Add(c.ttl)}
+	c.mu.Unlock()
+
+	return status, nil
+}
+
+// evictExpired - удаляет устаревшие записи (вызывается под блокировкой)
+func (c *Checker) evictExpired(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+}
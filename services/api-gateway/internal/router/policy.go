@@ -0,0 +1,46 @@
+package router
+
+import "api-gateway/internal/middleware"
+
+// Роли пользователей (совпадают с models.UserRole в auth-service)
+const (
+	roleStudent    = "student"
+	roleEmployer   = "employer"
+	roleUniversity = "university"
+	roleAdmin      = "admin"
+)
+
+// routePolicy - кто может вызывать защищённые маршруты.
+// Сервисы дополнительно проверяют владение ресурсами (своя вакансия, свой отклик).
+// Административные маршруты /api/auth/admin/* проксируются без проверки gateway:
+// роль администратора проверяет auth-service.
+var routePolicy = middleware.Policy{
+	// Профиль и резюме студента
+	{Method: "*", Path: "/api/students/*", Roles: []string{roleStudent}},
+
+	// Проверка БИН компаний
+	{Method: "*", Path: "/api/employers/verifications", Roles: []string{roleUniversity, roleAdmin}},
+	{Method: "*", Path: "/api/employers/verifications/*", Roles: []string{roleUniversity, roleAdmin}},
+
+	// Своя компания и её рекрутеры
+	{Method: "POST", Path: "/api/employers/companies", Roles: []string{roleEmployer}},
+	{Method: "*", Path: "/api/employers/companies/my", Roles: []string{roleEmployer}},
+	{Method: "*", Path: "/api/employers/companies/my/*", Roles: []string{roleEmployer}},
+	{Method: "*", Path: "/api/employers/invitations", Roles: []string{roleEmployer}},
+	{Method: "*", Path: "/api/employers/invitations/*", Roles: []string{roleEmployer}},
+
+	// Управление вакансиями (просмотр опубликованных доступен всем)
+	{Method: "GET", Path: "/api/vacancies/my", Roles: []string{roleEmployer}},
+	{Method: "POST", Path: "/api/vacancies", Roles: []string{roleEmployer}},
+	{Method: "PUT", Path: "/api/vacancies/:id", Roles: []string{roleEmployer}},
+	{Method: "DELETE", Path: "/api/vacancies/:id", Roles: []string{roleEmployer}},
+	{Method: "POST", Path: "/api/vacancies/:id/publish", Roles: []string{roleEmployer}},
+	{Method: "POST", Path: "/api/vacancies/:id/close", Roles: []string{roleEmployer}},
+
+	// Отклики: студент откликается, работодатель ведёт воронку
+	{Method: "POST", Path: "/api/applications", Roles: []string{roleStudent}},
+	{Method: "GET", Path: "/api/applications/my", Roles: []string{roleStudent}},
+	{Method: "POST", Path: "/api/applications/:id/withdraw", Roles: []string{roleStudent}},
+	{Method: "GET", Path: "/api/applications", Roles: []string{roleEmployer}},
+	{Method: "POST", Path: "/api/applications/:id/status", Roles: []string{roleEmployer}},
+}
@@ -0,0 +1,44 @@
+package router
+
+import (
+	"api-gateway/internal/config"
+	"api-gateway/internal/middleware"
+)
+
+// rateLimits - лимиты частоты запросов по группам маршрутов.
+// Вход и регистрация ограничены строже всего (подбор паролей, массовая регистрация),
+// чтение - мягче записи.
+func rateLimits(cfg *config.Config) middleware.RateLimits {
+	return middleware.RateLimits{
+		{
+			Name:  "login",
+			Limit: cfg.RateLimitLogin,
+			Key:   middleware.KeyByIP,
+			Routes: []middleware.Route{
+				{Method: "POST", Path: "/api/auth/login"},
+				{Method: "POST", Path: "/api/auth/login/mfa"},
+				{Method: "POST", Path: "/api/auth/register"},
+				{Method: "POST", Path: "/api/auth/password/forgot"},
+				{Method: "POST", Path: "/api/auth/oidc/exchange"},
+			},
+		},
+		{
+			Name:   "auth",
+			Limit:  cfg.RateLimitAuth,
+			Key:    middleware.KeyByIP,
+			Routes: []middleware.Route{{Method: "*", Path: "/api/auth/*"}},
+		},
+		{
+			Name:   "read",
+			Limit:  cfg.RateLimitRead,
+			Key:    middleware.KeyByUser,
+			Routes: []middleware.Route{{Method: "GET", Path: "/api/*"}},
+		},
+		{
+			Name:   "write",
+			Limit:  cfg.RateLimitWrite,
+			Key:    middleware.KeyByUser,
+			Routes: []middleware.Route{{Method: "*", Path: "/api/*"}},
+		},
+	}
+}
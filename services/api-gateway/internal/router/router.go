@@ -1,15 +1,39 @@
 package router
 
 import (
+	"log/slog"
+
 	"github.com/gin-gonic/gin"
 
 	"api-gateway/internal/config"
+	"api-gateway/internal/health"
+	"api-gateway/internal/jwks"
 	"api-gateway/internal/middleware"
 	"api-gateway/internal/proxy"
+	"api-gateway/internal/ratelimit"
+	"api-gateway/internal/revocation"
+	"observability/logging"
+	"observability/metrics"
+	"observability/server"
 )
 
 // SetupRoutes - настраивает все маршруты
-func SetupRoutes(r *gin.Engine, cfg *config.Config) {
+func SetupRoutes(r *gin.Engine, cfg *config.Config, readiness *server.Readiness) {
+	// Проверка отзыва токенов общая для всех защищённых маршрутов (общий кэш)
+	checker := newRevocationChecker(cfg)
+	keys := jwks.NewCache(cfg.JWKSURL, cfg.JWKSCacheTTL)
+	auth := middleware.AuthMiddleware(keys, checker)
+
+	// Проверка ролей по декларативной политике и подтверждения email (после аутентификации)
+	roles := middleware.RoleMiddleware(routePolicy)
+	verified := middleware.VerifiedEmailMiddleware()
+
+	// Ограничение частоты запросов: для защищённых маршрутов - после аутентификации (лимит на пользователя)
+	limit := newRateLimiter(cfg)
+
+	// Метрики количества и длительности запросов (для всех маршрутов, включая отклонённые).
+	// Сами метрики отдаются на внутреннем порту METRICS_PORT, а не через публичный порт gateway
+	r.Use(metrics.Middleware())
 
 	// API группа
 	api := r.Group("/api")
@@ -17,47 +41,150 @@ func SetupRoutes(r *gin.Engine, cfg *config.Config) {
 	// ============================================
 	// AUTH SERVICE - публичные эндпоинты
 	// ============================================
-	api.Any("/auth/*path", proxy.NewServiceProxy(cfg.AuthServiceUrl))
+	api.Any("/auth/*path", limit, proxy.NewServiceProxy(cfg.AuthServiceUrl))
 
 	// ============================================
 	// STUDENT SERVICE - защищённые эндпоинты
 	// ============================================
 	api.Any("/students/*path",
-		middleware.AuthMiddleware(cfg.JWTSecret),     // ← middleware первый
-		proxy.NewServiceProxy(cfg.StudentServiceUrl), // ← proxy второй
+		auth,     // ← аутентификация первая
+		limit,    // ← лимит частоты запросов пользователя
+		roles,    // ← затем проверка роли
+		verified, // ← изменения только с подтверждённым email
+		proxy.NewServiceProxy(cfg.StudentServiceUrl), // ← proxy последний
 	)
 
 	// ============================================
 	// EMPLOYER SERVICE - защищённые эндпоинты
 	// ============================================
 	api.Any("/employers/*path",
-		middleware.AuthMiddleware(cfg.JWTSecret),
+		auth,
+		limit,
+		roles,
+		verified,
 		proxy.NewServiceProxy(cfg.EmployerServiceUrl),
 	)
 
 	// ============================================
 	// VACANCY SERVICE
 	// ============================================
-	//api.Any("/vacancies/*path",
-	//	middleware.AuthMiddleware(cfg.JWTSecret),
-	//	proxy.NewServiceProxy(cfg.VacancyServiceURL),
-	//)
+	// Корневой путь регистрируется отдельно, чтобы GET/POST /api/vacancies
+	// не перенаправлялись на /api/vacancies/
+	vacancies := []gin.HandlerFunc{
+		auth,
+		limit,
+		roles,
+		verified,
+		proxy.NewServiceProxy(cfg.VacancyServiceUrl),
+	}
+	api.Any("/vacancies", vacancies...)
+	api.Any("/vacancies/*path", vacancies...)
+
+	// ============================================
+	// APPLICATIONS - отклики обслуживает vacancy-service
+	// ============================================
+	api.Any("/applications", vacancies...)
+	api.Any("/applications/*path", vacancies...)
 
 	// ============================================
 	// REPORT SERVICE
 	// ============================================
 	//api.Any("/reports/*path",
-	//	middleware.AuthMiddleware(cfg.JWTSecret),
+	//	auth,
+	//	limit,
+	//	roles,
+	//	verified,
 	//	proxy.NewServiceProxy(cfg.ReportServiceURL),
 	//)
 
 	// ============================================
 	// Health check
 	// ============================================
+	// /health и /health/live проверяют только сам gateway,
+	// /health/ready дополнительно опрашивает готовность сервисов за ним
+	r.GET("/health/live", func(c *gin.Context) {
+		c.JSON(200, gin.H{
+			"status":  "alive",
+			"service": "api-gateway",
+		})
+	})
+	r.GET("/health/ready", readinessHandler(cfg, readiness))
 	r.GET("/health", func(c *gin.Context) {
+		// Во время остановки gateway не принимает новые запросы
+		if !readiness.IsReady() {
+			c.JSON(503, gin.H{
+				"status":  "shutting_down",
+				"service": "api-gateway",
+			})
+			return
+		}
+
 		c.JSON(200, gin.H{
 			"status":  "ok",
 			"service": "api-gateway",
 		})
 	})
 }
+
+// readinessHandler - готовность gateway: сервер не останавливается и все сервисы за ним готовы
+func readinessHandler(cfg *config.Config, readiness *server.Readiness) gin.HandlerFunc {
+	checker := health.NewChecker([]health.Upstream{
+		{Name: "auth-service", URL: cfg.AuthServiceUrl},
+		{Name: "student-service", URL: cfg.StudentServiceUrl},
+		{Name: "employer-service", URL: cfg.EmployerServiceUrl},
+		{Name: "vacancy-service", URL: cfg.VacancyServiceUrl},
+	}, cfg.HealthCheckTimeout)
+
+	return func(c *gin.Context) {
+		// 1. Во время остановки сервисы не опрашиваем
+		if !readiness.IsReady() {
+			c.JSON(503, gin.H{
+				"status":  "shutting_down",
+				"service": "api-gateway",
+			})
+			return
+		}
+
+		// 2. Опрашиваем сервисы параллельно
+		components, ready := checker.Check(c.Request.Context())
+
+		// 3. Любой неготовый сервис делает gateway неготовым
+		status, code := "ready", 200
+		if !ready {
+			status, code = "not_ready", 503
+		}
+		c.JSON(code, gin.H{
+			"status":     status,
+			"service":    "api-gateway",
+			"components": components,
+		})
+	}
+}
+
+// newRevocationChecker - выбирает backend проверки отзыва по конфигурации
+func newRevocationChecker(cfg *config.Config) *revocation.Checker {
+	switch cfg.RevocationBackend {
+	case "none":
+		slog.Warn("Access token revocation check is disabled")
+		return nil
+	case "auth":
+		return revocation.NewChecker(revocation.NewAuthServiceBackend(cfg.AuthServiceUrl), cfg.RevocationCacheTTL)
+	default:
+		logging.Fatal("Unknown REVOCATION_BACKEND", "backend", cfg.RevocationBackend)
+		return nil
+	}
+}
+
+// newRateLimiter - выбирает хранилище лимитов частоты запросов по конфигурации
+func newRateLimiter(cfg *config.Config) gin.HandlerFunc {
+	switch cfg.RateLimitBackend {
+	case "none":
+		slog.Warn("Rate limiting is disabled")
+		return func(c *gin.Context) { c.Next() }
+	case "memory":
+		return middleware.RateLimitMiddleware(ratelimit.NewMemoryStore(), rateLimits(cfg))
+	default:
+		logging.Fatal("Unknown RATE_LIMIT_BACKEND", "backend", cfg.RateLimitBackend)
+		return nil
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"auth-service/internal/service"
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// createAdmin создаёт учётную запись администратора (команда create-admin).
+// Пароль берётся из переменной ADMIN_PASSWORD или читается первой строкой из stdin,
+// чтобы не оставаться в истории команд и списке процессов.
+func createAdmin(authService service.AuthService, args []string) error {
+	flags := flag.NewFlagSet("create-admin", flag.ContinueOnError)
+	email := flags.String("email", "", "email администратора")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *email == "" {
+		return errors.New("укажите -email")
+	}
+
+	password, ok := os.LookupEnv("ADMIN_PASSWORD")
+	if !ok {
+		fmt.Fprint(os.Stderr, "Пароль: ")
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return fmt.Errorf("не удалось прочитать пароль: %w", err)
+		}
+		password = strings.TrimRight(line, "\r\n")
+	}
+
+	user, err := authService.BootstrapAdmin(context.Background(), *email, password)
+	if err != nil {
+		var validationErr *service.ValidationError
+		if errors.As(err, &validationErr) {
+			for _, message := range validationErr.Details {
+				slog.Warn("Пароль не соответствует политике", "reason", message)
+			}
+		}
+		return err
+	}
+
+	slog.Info("Администратор создан", "email", user.Email, "user_id", user.ID)
+	return nil
+}
@@ -3,11 +3,25 @@ package main
 import (
 	"auth-service/internal/config"
 	"auth-service/internal/handler"
+	"auth-service/internal/lockout"
+	"auth-service/internal/mail"
+	"auth-service/internal/migrations"
+	"auth-service/internal/oidc"
+	"auth-service/internal/password"
 	"auth-service/internal/repository"
 	"auth-service/internal/router"
 	"auth-service/internal/service"
 	"auth-service/pkg/jwt"
-	"log"
+	"log/slog"
+	"observability/health"
+	"observability/logging"
+	"observability/metrics"
+	"observability/server"
+	"observability/tracing"
+	"os"
+	"time"
+
+	"github.com/uptrace/opentelemetry-go-extra/otelgorm"
 )
 
 // @title Auth Service API
@@ -20,29 +34,203 @@ func main() {
 	// Загрузка конфигурации из переменных окружения
 	cfg, err := config.LoadConfig()
 	if err != nil {
-		log.Fatalf("Ошибка загрузки конфигурации: %v", err)
+		logging.Fatal("Ошибка загрузки конфигурации", "error", err)
 	}
 
+	// Структурированный журнал (JSON) с маскированием персональных данных
+	logging.Setup(cfg.LogLevel)
+
 	// Подключение к базе данных PostgreSQL
 	db, err := config.ConnectDatabase(cfg)
 	if err != nil {
-		log.Fatalf("Ошибка подключения к базе данных: %v", err)
+		logging.Fatal("Ошибка подключения к базе данных", "error", err)
+	}
+
+	// Версионные миграции схемы: auth-service migrate up|down|status
+	migrator, err := migrations.NewMigrator(db)
+	if err != nil {
+		logging.Fatal("Ошибка загрузки миграций", "error", err)
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(migrator, os.Args[2:]); err != nil {
+			logging.Fatal("Ошибка миграции базы данных", "error", err)
+		}
+		return
+	}
+	if err := prepareSchema(migrator, cfg.DBMigrateOnStart); err != nil {
+		logging.Fatal("Схема базы данных не готова", "error", err)
+	}
+
+	// Трассировка запросов; операции отправляются в OTLP коллектор, если он задан
+	shutdownTracing, err := tracing.Setup("auth-service", cfg.OTLPEndpoint)
+	if err != nil {
+		logging.Fatal("Ошибка настройки трассировки", "error", err)
+	}
+	if cfg.OTLPEndpoint != "" {
+		slog.Info("Трассировки отправляются в OTLP коллектор", "endpoint", cfg.OTLPEndpoint)
+	}
+	// Операции SQL запросов: текст запроса без связанных значений (в них персональные данные),
+	// статистика пула соединений уже отдаётся метриками
+	if err := db.Use(otelgorm.NewPlugin(otelgorm.WithDBName(cfg.DBName), otelgorm.WithoutQueryVariables(), otelgorm.WithoutMetrics())); err != nil {
+		logging.Fatal("Ошибка подключения трассировки SQL запросов", "error", err)
+	}
+
+	// Загрузка ключей подписи и инициализация JWT менеджера
+	signingKeys, err := jwt.LoadKeySet(cfg.JWTKeysDir, cfg.JWTSigningKeyID, cfg.JWTSigningAlgorithm)
+	if err != nil {
+		logging.Fatal("Ошибка загрузки ключей подписи JWT", "error", err)
+	}
+	slog.Info("Активный ключ подписи JWT", "kid", signingKeys.ActiveKeyID())
+	jwtManager := jwt.NewJWTManager(signingKeys, cfg.JWTExpirationHours)
+
+	// Инициализация отправки писем
+	mailSender, err := mail.NewSender(cfg.MailSender, cfg.MailFrom, cfg.MailFileDir)
+	if err != nil {
+		logging.Fatal("Ошибка настройки отправки писем", "error", err)
 	}
 
-	// Инициализация JWT менеджера
-	jwtManager := jwt.NewJWTManager(cfg.JWTSecret, cfg.JWTExpirationHours)
+	// Инициализация политики паролей
+	passwordPolicy := &password.Policy{
+		MinLength:      cfg.PasswordMinLength,
+		MaxBytes:       cfg.PasswordMaxBytes,
+		RequireLower:   cfg.PasswordRequireLower,
+		RequireUpper:   cfg.PasswordRequireUpper,
+		RequireDigit:   cfg.PasswordRequireDigit,
+		RequireSpecial: cfg.PasswordRequireSpecial,
+		ForbidEmail:    cfg.PasswordForbidEmail,
+	}
+	if cfg.BreachedPasswordsPath != "" {
+		passwordPolicy.Breached, err = password.NewBreachedList(cfg.BreachedPasswordsPath)
+		if err != nil {
+			logging.Fatal("Ошибка загрузки списка скомпрометированных паролей", "error", err)
+		}
+	}
+
+	// Инициализация защиты входа от перебора паролей
+	lockoutEventRepo := repository.NewLockoutEventRepository(db)
+	var lockoutStore lockout.Store
+	switch cfg.LockoutStore {
+	case "postgres":
+		lockoutStore = lockout.NewPostgresStore(db)
+	case "memory":
+		lockoutStore = lockout.NewMemoryStore()
+	default:
+		logging.Fatal("Неизвестное хранилище блокировок входа", "store", cfg.LockoutStore)
+	}
+	loginGuard := lockout.NewGuard(lockoutStore, lockoutEventRepo, lockout.Config{
+		MaxAccountFailures: cfg.LoginMaxAccountFailures,
+		MaxIPFailures:      cfg.LoginMaxIPFailures,
+		Window:             time.Duration(cfg.LoginFailureWindowMinutes) * time.Minute,
+		LockDuration:       time.Duration(cfg.LoginLockoutMinutes) * time.Minute,
+	})
 
 	// Инициализация слоёв приложения
 	userRepo := repository.NewUserRepository(db)
-	authService := service.NewAuthService(userRepo, jwtManager)
+	profileRepo := repository.NewProfileRepository(db)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	sessionRepo := repository.NewSessionRepository(db)
+	userTokenRepo := repository.NewUserTokenRepository(db)
+	mfaRepo := repository.NewMFARepository(db)
+	externalIdentityRepo := repository.NewExternalIdentityRepository(db)
+	adminAuditRepo := repository.NewAdminAuditRepository(db)
+	invitationRepo := repository.NewInvitationRepository(db)
+	transactor := repository.NewTransactor(db)
+
+	// Провайдеры входа (SSO университетов)
+	oidcProviders := make(map[string]*oidc.Provider, len(cfg.OIDCProviders))
+	for _, provider := range cfg.OIDCProviders {
+		oidcProviders[provider.Name] = oidc.NewProvider(oidc.Config{
+			Name:           provider.Name,
+			DisplayName:    provider.DisplayName,
+			Issuer:         provider.Issuer,
+			ClientID:       provider.ClientID,
+			ClientSecret:   provider.ClientSecret,
+			Scopes:         provider.Scopes,
+			StudentDomains: provider.StudentDomains,
+		})
+	}
+
+	authService := service.NewAuthService(
+		userRepo,
+		profileRepo,
+		refreshTokenRepo,
+		sessionRepo,
+		userTokenRepo,
+		lockoutEventRepo,
+		mfaRepo,
+		externalIdentityRepo,
+		adminAuditRepo,
+		invitationRepo,
+		transactor,
+		jwtManager,
+		mailSender,
+		service.Options{
+			AppURL:               cfg.AppURL,
+			EmailVerificationTTL: cfg.EmailVerificationTTL,
+			PasswordResetTTL:     cfg.PasswordResetTTL,
+			InvitationTTL:        cfg.InvitationTTL,
+			PasswordPolicy:       passwordPolicy,
+			LoginGuard:           loginGuard,
+			MFAIssuer:            cfg.MFAIssuer,
+			MFAChallengeTTL:      cfg.MFAChallengeTTL,
+			OIDCProviders:        oidcProviders,
+			OIDCRedirectBaseURL:  cfg.OIDCRedirectBaseURL,
+		},
+	)
+
+	// Создание первого администратора: auth-service create-admin -email admin@example.com
+	if len(os.Args) > 1 && os.Args[1] == "create-admin" {
+		if err := createAdmin(authService, os.Args[2:]); err != nil {
+			logging.Fatal("Ошибка создания администратора", "error", err)
+		}
+		return
+	}
+
 	authHandler := handler.NewAuthHandler(authService)
+	adminHandler := handler.NewAdminHandler(authService)
+
+	// Пул соединений с БД проверяется в /health/ready, отражается в /metrics и закрывается после завершения обрабатываемых запросов
+	sqlDB, err := db.DB()
+	if err != nil {
+		logging.Fatal("Ошибка получения пула соединений с базой данных", "error", err)
+	}
+	metrics.RegisterDBStats(sqlDB, cfg.DBName)
+	readiness := server.NewReadiness()
+	healthHandler := health.NewHandler("auth-service", sqlDB, readiness, cfg.HealthCheckTimeout)
 
 	// Создание и настройка роутера
-	r := router.SetupRouter(authHandler, jwtManager)
+	r := router.SetupRouter(authHandler, adminHandler, authService, jwtManager, healthHandler)
 
-	// Запуск HTTP сервера
-	log.Printf("Auth Service запущен на порту %s", cfg.ServerPort)
-	if err := r.Run(":" + cfg.ServerPort); err != nil {
-		log.Fatalf("Ошибка запуска сервера: %v", err)
+	// IP клиента из X-Forwarded-For принимается только от API Gateway: иначе клиент мог бы обойти
+	// блокировку входа по IP или направить её на чужой адрес
+	if err := r.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		logging.Fatal("Некорректное значение TRUSTED_PROXIES", "error", err)
+	}
+
+	// Метрики отдаются на отдельном внутреннем порту, который не публикуется вместе с портом API
+	closers := []func() error{sqlDB.Close, shutdownTracing}
+	if cfg.MetricsPort != "" {
+		closeMetrics, err := metrics.Serve(":" + cfg.MetricsPort)
+		if err != nil {
+			logging.Fatal("Ошибка запуска сервера метрик", "error", err)
+		}
+		slog.Info("Метрики доступны на внутреннем порту", "port", cfg.MetricsPort)
+		closers = append(closers, closeMetrics)
+	}
+
+	// Запуск HTTP сервера (до SIGINT/SIGTERM)
+	slog.Info("Auth Service запущен", "port", cfg.ServerPort)
+	err = server.Run(r, server.Config{
+		Addr:              ":" + cfg.ServerPort,
+		ReadTimeout:       cfg.ServerReadTimeout,
+		ReadHeaderTimeout: cfg.ServerReadHeaderTimeout,
+		WriteTimeout:      cfg.ServerWriteTimeout,
+		IdleTimeout:       cfg.ServerIdleTimeout,
+		ShutdownDelay:     cfg.ShutdownDelay,
+		ShutdownTimeout:   cfg.ShutdownTimeout,
+	}, readiness, closers...)
+	if err != nil {
+		logging.Fatal("Ошибка работы сервера", "error", err)
 	}
+	slog.Info("Auth Service остановлен")
 }
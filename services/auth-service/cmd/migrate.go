@@ -0,0 +1,94 @@
+package main
+
+import (
+	"auth-service/internal/migrations"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+	"time"
+)
+
+// runMigrate выполняет команду migrate:
+//
+//	auth-service migrate up               - применить все новые миграции
+//	auth-service migrate down [-steps N]  - откатить N последних миграций (по умолчанию одну)
+//	auth-service migrate status           - показать применённые и ожидающие миграции
+func runMigrate(migrator *migrations.Migrator, args []string) error {
+	if len(args) == 0 {
+		return errors.New("укажите действие: migrate up|down|status")
+	}
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			slog.Info("Миграция применена", "version", migration.Version, "name", migration.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			slog.Info("Новых миграций нет")
+		}
+		return err
+
+	case "down":
+		flags := flag.NewFlagSet("migrate down", flag.ContinueOnError)
+		steps := flags.Int("steps", 1, "число откатываемых миграций")
+		if err := flags.Parse(args[1:]); err != nil {
+			return err
+		}
+		if *steps < 1 {
+			return errors.New("-steps должен быть не меньше 1")
+		}
+		reverted, err := migrator.Down(ctx, *steps)
+		for _, migration := range reverted {
+			slog.Info("Миграция откачена", "version", migration.Version, "name", migration.Name)
+		}
+		return err
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		out := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(out, "VERSION\tNAME\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(out, "%d\t%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+		return out.Flush()
+
+	default:
+		return fmt.Errorf("неизвестное действие %q: migrate up|down|status", args[0])
+	}
+}
+
+// prepareSchema проверяет схему БД перед запуском сервиса: применяет новые миграции,
+// если это разрешено настройкой, иначе отказывается работать со старой схемой
+func prepareSchema(migrator *migrations.Migrator, migrateOnStart bool) error {
+	ctx := context.Background()
+	if migrateOnStart {
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			slog.Info("Миграция применена", "version", migration.Version, "name", migration.Name)
+		}
+		return err
+	}
+
+	pending, err := migrator.Pending(ctx)
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("не применено миграций: %d (первая - %d_%s), выполните auth-service migrate up",
+			len(pending), pending[0].Version, pending[0].Name)
+	}
+	return nil
+}
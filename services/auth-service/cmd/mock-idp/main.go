@@ -0,0 +1,37 @@
+// Команда mock-idp запускает учебного провайдера OpenID Connect для локальной разработки
+// и проверки входа через SSO университета. Пароли не проверяются: пользователь вводит
+// любой email и сразу возвращается в приложение. Не использовать в production.
+package main
+
+import (
+	"auth-service/internal/oidc/oidctest"
+	"log"
+	"net/http"
+	"os"
+)
+
+func main() {
+	port := getEnv("MOCK_IDP_PORT", "9000")
+	clientID := getEnv("MOCK_IDP_CLIENT_ID", "student-employment")
+	provider, err := oidctest.NewProvider(
+		getEnv("MOCK_IDP_ISSUER", "http://localhost:"+port),
+		clientID,
+		getEnv("MOCK_IDP_CLIENT_SECRET", "secret"),
+	)
+	if err != nil {
+		log.Fatalf("Ошибка генерации ключа: %v", err)
+	}
+
+	log.Printf("Mock IdP запущен на порту %s (issuer %s, client_id %s)", port, provider.Issuer(), clientID)
+	if err := http.ListenAndServe(":"+port, provider.Handler()); err != nil {
+		log.Fatalf("Ошибка запуска сервера: %v", err)
+	}
+}
+
+// getEnv получает значение переменной окружения или возвращает значение по умолчанию
+func getEnv(key, defaultValue string) string {
+	if value, exists := os.LookupEnv(key); exists {
+		return value
+	}
+	return defaultValue
+}
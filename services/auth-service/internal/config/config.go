@@ -2,8 +2,11 @@ package config
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -11,7 +14,14 @@ import (
 // Config содержит все настройки приложения
 type Config struct {
 	// Настройки сервера
-	ServerPort string
+	ServerPort              string
+	ServerReadTimeout       time.Duration
+	ServerReadHeaderTimeout time.Duration
+	ServerWriteTimeout      time.Duration
+	ServerIdleTimeout       time.Duration
+	ShutdownDelay           time.Duration // Пауза после снятия готовности перед остановкой
+	ShutdownTimeout         time.Duration // Время на завершение обрабатываемых запросов
+	HealthCheckTimeout      time.Duration // Ограничение проверки базы данных в /health/ready
 
 	// Настройки базы данных PostgreSQL
 	DBHost     string
@@ -21,9 +31,83 @@ type Config struct {
 	DBName     string
 	DBSSLMode  string
 
+	// SQL запросы дольше порога записываются в журнал с уровнем Warn (0 - не записывать)
+	DBSlowQueryThreshold time.Duration
+
+	// Применять миграции схемы при запуске; иначе сервис не запускается, пока не выполнена команда migrate up
+	DBMigrateOnStart bool
+
+	// Уровень журнала: debug, info, warn или error (на уровне debug выводятся все SQL запросы)
+	LogLevel slog.Level
+
 	// Настройки JWT
-	JWTSecret          string
-	JWTExpirationHours int
+	JWTKeysDir          string // Каталог закрытых ключей подписи "<kid>.pem"
+	JWTSigningKeyID     string // kid активного ключа (по умолчанию - наибольший kid в каталоге)
+	JWTSigningAlgorithm string // Алгоритм нового ключа, если каталог пуст: EdDSA или RS256
+	JWTExpirationHours  int
+
+	// Настройки почты
+	MailSender  string // Способ отправки: log или file
+	MailFrom    string
+	MailFileDir string // Каталог для писем при MAIL_SENDER=file
+
+	// Адрес веб-приложения для ссылок в письмах
+	AppURL string
+
+	// Время жизни ссылки подтверждения email
+	EmailVerificationTTL time.Duration
+
+	// Время жизни ссылки сброса пароля
+	PasswordResetTTL time.Duration
+
+	// Время жизни приглашения зарегистрироваться
+	InvitationTTL time.Duration
+
+	// Политика паролей
+	PasswordMinLength      int
+	PasswordMaxBytes       int
+	PasswordRequireLower   bool
+	PasswordRequireUpper   bool
+	PasswordRequireDigit   bool
+	PasswordRequireSpecial bool
+	PasswordForbidEmail    bool
+	BreachedPasswordsPath  string // Пустое значение отключает проверку по списку утечек
+
+	// Защита входа от перебора паролей
+	LockoutStore              string // Хранилище счётчиков: postgres или memory
+	LoginMaxAccountFailures   int
+	LoginMaxIPFailures        int
+	LoginFailureWindowMinutes int
+	LoginLockoutMinutes       int
+
+	// Двухфакторная аутентификация
+	MFAIssuer       string // Название сервиса в приложении-аутентификаторе
+	MFAChallengeTTL time.Duration
+
+	// Вход через провайдеров OIDC (SSO университетов)
+	OIDCProviders       []OIDCProviderConfig
+	OIDCRedirectBaseURL string // Внешний адрес API Gateway, на который провайдер возвращает пользователя
+
+	// Адреса и подсети прокси, которым доверяется X-Forwarded-For (API Gateway). IP клиента для
+	// блокировок входа и сеансов берётся из заголовка, только если запрос пришёл от такого прокси
+	TrustedProxies []string
+
+	// Адрес OTLP/HTTP коллектора трассировок (например, http://localhost:4318; пусто - не отправлять)
+	OTLPEndpoint string
+
+	// Внутренний порт /metrics; не публикуется вместе с SERVER_PORT (пусто - метрики не отдаются)
+	MetricsPort string
+}
+
+// OIDCProviderConfig содержит настройки провайдера входа из переменных OIDC_<ИМЯ>_*
+type OIDCProviderConfig struct {
+	Name           string
+	DisplayName    string
+	Issuer         string
+	ClientID       string
+	ClientSecret   string
+	Scopes         []string
+	StudentDomains []string
 }
 
 // LoadConfig загружает конфигурацию из переменных окружения
@@ -39,12 +123,46 @@ func LoadConfig() (*Config, error) {
 		DBPassword: getEnv("DB_PASSWORD", "Supoga80"),
 		DBName:     getEnv("DB_NAME", "postgres"),
 		DBSSLMode:  getEnv("DB_SSLMODE", "disable"),
-		JWTSecret:  getEnv("JWT_SECRET", "some_jwt_secret"),
+
+		JWTKeysDir:          getEnv("JWT_KEYS_DIR", "./keys"),
+		JWTSigningKeyID:     getEnv("JWT_SIGNING_KEY_ID", ""),
+		JWTSigningAlgorithm: getEnv("JWT_SIGNING_ALGORITHM", "EdDSA"),
+
+		MailSender:  getEnv("MAIL_SENDER", "log"),
+		MailFrom:    getEnv("MAIL_FROM", "noreply@student-employment.local"),
+		MailFileDir: getEnv("MAIL_FILE_DIR", "./mail"),
+		AppURL:      strings.TrimRight(getEnv("APP_URL", "http://localhost:5173"), "/"),
+		MFAIssuer:   getEnv("MFA_ISSUER", "Student Employment"),
+
+		OTLPEndpoint: getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", ""),
+		MetricsPort:  getEnv("METRICS_PORT", "9090"),
+
+		// По умолчанию - локальный и внутренние сети, из которых сервис вызывает только API Gateway
+		// (снаружи auth-service недоступен); в production лучше указать адрес gateway
+		TrustedProxies: splitList(getEnv("TRUSTED_PROXIES", "127.0.0.1,::1,10.0.0.0/8,172.16.0.0/12,192.168.0.0/16,fc00::/7")),
 	}
 
-	// Проверка обязательных переменных
-	if config.JWTSecret == "" {
-		return nil, fmt.Errorf("JWT_SECRET не установлен")
+	// Парсинг таймаутов HTTP сервера, его остановки и проверки готовности. По умолчанию
+	// остановка укладывается в 10 секунд, которые Docker ждёт после SIGTERM
+	timeouts := []struct {
+		key   string
+		value *time.Duration
+		def   string
+	}{
+		{"SERVER_READ_TIMEOUT_SECONDS", &config.ServerReadTimeout, "15"},
+		{"SERVER_READ_HEADER_TIMEOUT_SECONDS", &config.ServerReadHeaderTimeout, "5"},
+		{"SERVER_WRITE_TIMEOUT_SECONDS", &config.ServerWriteTimeout, "30"},
+		{"SERVER_IDLE_TIMEOUT_SECONDS", &config.ServerIdleTimeout, "60"},
+		{"SHUTDOWN_DELAY_SECONDS", &config.ShutdownDelay, "2"},
+		{"SHUTDOWN_TIMEOUT_SECONDS", &config.ShutdownTimeout, "7"},
+		{"HEALTH_CHECK_TIMEOUT_SECONDS", &config.HealthCheckTimeout, "2"},
+	}
+	for _, timeout := range timeouts {
+		seconds, err := strconv.Atoi(getEnv(timeout.key, timeout.def))
+		if err != nil {
+			return nil, fmt.Errorf("некорректное значение %s: %v", timeout.key, err)
+		}
+		*timeout.value = time.Duration(seconds) * time.Second
 	}
 
 	// Парсинг времени жизни JWT токена
@@ -54,6 +172,109 @@ func LoadConfig() (*Config, error) {
 	}
 	config.JWTExpirationHours = jwtExpHours
 
+	// Парсинг времени жизни ссылки подтверждения email
+	verificationTTLHours, err := strconv.Atoi(getEnv("EMAIL_VERIFICATION_TTL_HOURS", "24"))
+	if err != nil {
+		return nil, fmt.Errorf("некорректное значение EMAIL_VERIFICATION_TTL_HOURS: %v", err)
+	}
+	config.EmailVerificationTTL = time.Duration(verificationTTLHours) * time.Hour
+
+	// Парсинг времени жизни ссылки сброса пароля
+	resetTTLMinutes, err := strconv.Atoi(getEnv("PASSWORD_RESET_TTL_MINUTES", "60"))
+	if err != nil {
+		return nil, fmt.Errorf("некорректное значение PASSWORD_RESET_TTL_MINUTES: %v", err)
+	}
+	config.PasswordResetTTL = time.Duration(resetTTLMinutes) * time.Minute
+
+	// Парсинг времени жизни приглашения
+	invitationTTLHours, err := strconv.Atoi(getEnv("INVITATION_TTL_HOURS", "72"))
+	if err != nil {
+		return nil, fmt.Errorf("некорректное значение INVITATION_TTL_HOURS: %v", err)
+	}
+	config.InvitationTTL = time.Duration(invitationTTLHours) * time.Hour
+
+	// Парсинг политики паролей
+	if config.PasswordMinLength, err = strconv.Atoi(getEnv("PASSWORD_MIN_LENGTH", "8")); err != nil {
+		return nil, fmt.Errorf("некорректное значение PASSWORD_MIN_LENGTH: %v", err)
+	}
+	if config.PasswordMaxBytes, err = strconv.Atoi(getEnv("PASSWORD_MAX_BYTES", "72")); err != nil {
+		return nil, fmt.Errorf("некорректное значение PASSWORD_MAX_BYTES: %v", err)
+	}
+	flags := []struct {
+		key   string
+		value *bool
+		def   string
+	}{
+		{"PASSWORD_REQUIRE_LOWER", &config.PasswordRequireLower, "true"},
+		{"PASSWORD_REQUIRE_UPPER", &config.PasswordRequireUpper, "true"},
+		{"PASSWORD_REQUIRE_DIGIT", &config.PasswordRequireDigit, "true"},
+		{"PASSWORD_REQUIRE_SPECIAL", &config.PasswordRequireSpecial, "false"},
+		{"PASSWORD_FORBID_EMAIL", &config.PasswordForbidEmail, "true"},
+	}
+	for _, flag := range flags {
+		if *flag.value, err = strconv.ParseBool(getEnv(flag.key, flag.def)); err != nil {
+			return nil, fmt.Errorf("некорректное значение %s: %v", flag.key, err)
+		}
+	}
+	config.BreachedPasswordsPath = getEnv("BREACHED_PASSWORDS_PATH", "data/breached-passwords.txt")
+
+	// Парсинг параметров защиты входа
+	config.LockoutStore = getEnv("LOCKOUT_STORE", "postgres")
+	limits := []struct {
+		key   string
+		value *int
+		def   string
+	}{
+		{"LOGIN_MAX_ACCOUNT_FAILURES", &config.LoginMaxAccountFailures, "5"},
+		{"LOGIN_MAX_IP_FAILURES", &config.LoginMaxIPFailures, "50"},
+		{"LOGIN_FAILURE_WINDOW_MINUTES", &config.LoginFailureWindowMinutes, "15"},
+		{"LOGIN_LOCKOUT_MINUTES", &config.LoginLockoutMinutes, "15"},
+	}
+	for _, limit := range limits {
+		if *limit.value, err = strconv.Atoi(getEnv(limit.key, limit.def)); err != nil {
+			return nil, fmt.Errorf("некорректное значение %s: %v", limit.key, err)
+		}
+	}
+
+	// Парсинг уровня журнала и порога медленных SQL запросов
+	if err := config.LogLevel.UnmarshalText([]byte(getEnv("LOG_LEVEL", "info"))); err != nil {
+		return nil, fmt.Errorf("некорректное значение LOG_LEVEL: %v", err)
+	}
+	slowQueryMs, err := strconv.Atoi(getEnv("DB_SLOW_QUERY_THRESHOLD_MS", "200"))
+	if err != nil {
+		return nil, fmt.Errorf("некорректное значение DB_SLOW_QUERY_THRESHOLD_MS: %v", err)
+	}
+	config.DBSlowQueryThreshold = time.Duration(slowQueryMs) * time.Millisecond
+	if config.DBMigrateOnStart, err = strconv.ParseBool(getEnv("DB_MIGRATE_ON_START", "false")); err != nil {
+		return nil, fmt.Errorf("некорректное значение DB_MIGRATE_ON_START: %v", err)
+	}
+
+	// Парсинг времени на ввод кода второго фактора
+	challengeTTLMinutes, err := strconv.Atoi(getEnv("MFA_CHALLENGE_TTL_MINUTES", "5"))
+	if err != nil {
+		return nil, fmt.Errorf("некорректное значение MFA_CHALLENGE_TTL_MINUTES: %v", err)
+	}
+	config.MFAChallengeTTL = time.Duration(challengeTTLMinutes) * time.Minute
+
+	// Парсинг провайдеров входа: OIDC_PROVIDERS=kaznu,aitu и OIDC_KAZNU_ISSUER=... для каждого
+	config.OIDCRedirectBaseURL = strings.TrimRight(getEnv("OIDC_REDIRECT_BASE_URL", "http://localhost:8080"), "/")
+	for _, name := range splitList(getEnv("OIDC_PROVIDERS", "")) {
+		prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+		provider := OIDCProviderConfig{
+			Name:           name,
+			DisplayName:    getEnv(prefix+"DISPLAY_NAME", name),
+			Issuer:         strings.TrimRight(getEnv(prefix+"ISSUER", ""), "/"),
+			ClientID:       getEnv(prefix+"CLIENT_ID", ""),
+			ClientSecret:   getEnv(prefix+"CLIENT_SECRET", ""),
+			Scopes:         splitList(getEnv(prefix+"SCOPES", "")),
+			StudentDomains: splitList(getEnv(prefix+"STUDENT_DOMAINS", "")),
+		}
+		if provider.Issuer == "" || provider.ClientID == "" {
+			return nil, fmt.Errorf("для провайдера %s нужно задать %sISSUER и %sCLIENT_ID", name, prefix, prefix)
+		}
+		config.OIDCProviders = append(config.OIDCProviders, provider)
+	}
+
 	return config, nil
 }
 
@@ -65,6 +286,17 @@ func (c *Config) GetDSN() string {
 	)
 }
 
+// splitList разбирает список значений через запятую, пропуская пустые
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // getEnv получает значение переменной окружения или возвращает значение по умолчанию
 func getEnv(key, defaultValue string) string {
 	if value, exists := os.LookupEnv(key); exists {
@@ -1,20 +1,20 @@
 package config
 
 import (
-	"auth-service/internal/models"
 	"fmt"
-	"log"
+	"log/slog"
+	"observability/gormobs"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
 )
 
-// ConnectDatabase устанавливает соединение с PostgreSQL и выполняет миграции
+// ConnectDatabase устанавливает соединение с PostgreSQL.
+// Схема создаётся версионными миграциями (команда migrate), а не при подключении.
 func ConnectDatabase(cfg *Config) (*gorm.DB, error) {
-	// Настройка логгера GORM
+	// Журнал SQL запросов: ошибки и медленные запросы, все запросы - на уровне debug
 	gormConfig := &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+		Logger: gormobs.NewLogger(slog.Default(), cfg.DBSlowQueryThreshold),
 	}
 
 	// Подключение к базе данных
@@ -33,31 +33,6 @@ func ConnectDatabase(cfg *Config) (*gorm.DB, error) {
 	sqlDB.SetMaxIdleConns(10)
 	sqlDB.SetMaxOpenConns(100)
 
-	// Автоматическая миграция моделей
-	if err := runMigrations(db); err != nil {
-		return nil, fmt.Errorf("ошибка миграции: %w", err)
-	}
-
-	log.Println("Успешное подключение к базе данных PostgreSQL")
+	slog.Info("Успешное подключение к базе данных PostgreSQL")
 	return db, nil
 }
-
-// runMigrations выполняет автоматическую миграцию всех моделей
-func runMigrations(db *gorm.DB) error {
-	// Создание типа enum для ролей, если не существует
-	db.Exec(`
-		DO $$ BEGIN
-			CREATE TYPE user_role AS ENUM ('student', 'employer', 'university', 'admin');
-		EXCEPTION
-			WHEN duplicate_object THEN null;
-		END $$;
-	`)
-
-	// Миграция модели User
-	if err := db.AutoMigrate(&models.User{}); err != nil {
-		return fmt.Errorf("ошибка миграции модели User: %w", err)
-	}
-
-	log.Println("Миграции выполнены успешно")
-	return nil
-}
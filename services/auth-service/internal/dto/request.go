@@ -1,21 +1,149 @@
 package dto
 
-import "auth-service/internal/models"
+import (
+	"auth-service/internal/models"
+	"time"
+)
 
-// RegisterRequest представляет запрос на регистрацию нового пользователя
+// RegisterRequest представляет запрос на регистрацию нового пользователя.
+// Без приглашения доступны роли student и employer, university - после одобрения администратором.
+// С приглашением роль берётся из приглашения.
 type RegisterRequest struct {
-	Email    string          `json:"email" binding:"required,email" example:"user@example.com"`
-	Password string          `json:"password" binding:"required,min=8" example:"password123"`
-	Role     models.UserRole `json:"role" binding:"required,oneof=student employer university admin" example:"student"`
+	Email           string          `json:"email" binding:"required,email" example:"user@example.com"`
+	Password        string          `json:"password" binding:"required" example:"Str0ngPassw0rd"` // Требования задаёт политика паролей
+	Role            models.UserRole `json:"role" binding:"required_without=InvitationToken,omitempty,oneof=student employer university" example:"student"`
+	InvitationToken string          `json:"invitation_token,omitempty" example:"q3Vh1c0dE..."`
 }
 
 // LoginRequest представляет запрос на аутентификацию
 type LoginRequest struct {
 	Email    string `json:"email" binding:"required,email" example:"user@example.com"`
-	Password string `json:"password" binding:"required" example:"password123"`
+	Password string `json:"password" binding:"required" example:"Str0ngPassw0rd"`
 }
 
 // RefreshRequest представляет запрос на обновление токена
 type RefreshRequest struct {
 	RefreshToken string `json:"refresh_token" binding:"required" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
 }
+
+// VerifyEmailRequest представляет запрос на подтверждение email по ссылке из письма
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+}
+
+// ForgotPasswordRequest представляет запрос на отправку ссылки для сброса пароля
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email" example:"user@example.com"`
+}
+
+// ResetPasswordRequest представляет запрос на установку нового пароля по ссылке из письма
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required" example:"Xq3kz9P0bW7rT1yU5mN8vC2dF6hJ4sL0aE9gR3tY7uI"`
+	NewPassword string `json:"new_password" binding:"required" example:"N3wStr0ngPassw0rd"`
+}
+
+// ChangePasswordRequest представляет запрос на смену пароля аутентифицированным пользователем.
+// Требования к новому паролю совпадают с требованиями при регистрации.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required" example:"Str0ngPassw0rd"`
+	NewPassword     string `json:"new_password" binding:"required" example:"N3wStr0ngPassw0rd"`
+}
+
+// MFALoginRequest представляет второй шаг входа: код из приложения или код восстановления
+type MFALoginRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	Code           string `json:"code" binding:"required_without=RecoveryCode" example:"123456"`
+	RecoveryCode   string `json:"recovery_code" example:"k7m2-x9qp"`
+}
+
+// MFAChallengeRequest представляет запрос с токеном второго шага входа
+type MFAChallengeRequest struct {
+	ChallengeToken string `json:"challenge_token" binding:"required" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+}
+
+// MFACodeRequest представляет запрос с кодом из приложения-аутентификатора
+type MFACodeRequest struct {
+	Code string `json:"code" binding:"required" example:"123456"`
+}
+
+// DisableMFARequest представляет запрос на отключение второго фактора
+type DisableMFARequest struct {
+	Password string `json:"password" binding:"required" example:"Str0ngPassw0rd"`
+	Code     string `json:"code" binding:"required" example:"123456"`
+}
+
+// MFARolePolicyRequest представляет изменение требования второго фактора для роли
+type MFARolePolicyRequest struct {
+	Required *bool `json:"required" binding:"required" example:"true"`
+}
+
+// OIDCCallbackRequest представляет параметры возврата пользователя от провайдера входа
+type OIDCCallbackRequest struct {
+	Code  string `form:"code"`
+	State string `form:"state"`
+	Error string `form:"error"`
+
+	// BrowserState - state из cookie браузера, начавшего вход; должен совпасть с State
+	BrowserState string `form:"-"`
+}
+
+// OIDCExchangeRequest представляет обмен одноразового кода входа через провайдера на токены
+type OIDCExchangeRequest struct {
+	Code string `json:"code" binding:"required" example:"q3Vh1c0dE..."`
+}
+
+// UnlockLoginRequest представляет запрос администратора на снятие блокировки входа.
+// Указывается email учётной записи, IP-адрес или оба значения.
+type UnlockLoginRequest struct {
+	Email     string `json:"email" binding:"omitempty,email" example:"user@example.com"`
+	IPAddress string `json:"ip_address" binding:"omitempty,ip" example:"192.168.1.10"`
+}
+
+// ListUsersRequest представляет фильтры списка пользователей для администратора
+type ListUsersRequest struct {
+	Query       string     `form:"q" example:"company.kz"`
+	Role        string     `form:"role" binding:"omitempty,oneof=student employer university admin" example:"employer"`
+	Approval    string     `form:"approval_status" binding:"omitempty,oneof=approved pending rejected" example:"pending"`
+	Active      *bool      `form:"active" example:"true"`
+	CreatedFrom *time.Time `form:"created_from" time_format:"2006-01-02" example:"2024-01-01"`
+	CreatedTo   *time.Time `form:"created_to" time_format:"2006-01-02" example:"2024-01-31"` // Включительно
+}
+
+// AdminActionRequest представляет необязательную причину действия администратора (попадает в журнал)
+type AdminActionRequest struct {
+	Reason string `json:"reason" binding:"max=500" example:"Рассылка спама"`
+}
+
+// InvitationRequest представляет приглашение пользователя с указанной ролью
+type InvitationRequest struct {
+	Email string          `json:"email" binding:"required,email" example:"career@kaznu.kz"`
+	Role  models.UserRole `json:"role" binding:"required,oneof=student employer university admin" example:"university"`
+}
+
+// ChangeRoleRequest представляет изменение роли пользователя администратором
+type ChangeRoleRequest struct {
+	Role   models.UserRole `json:"role" binding:"required" example:"employer"`
+	Reason string          `json:"reason" binding:"max=500" example:"Ошибка при регистрации"`
+}
+
+// ClientInfo содержит сведения о клиенте, от которого пришёл запрос
+type ClientInfo struct {
+	IPAddress string
+	UserAgent string
+}
+
+// UpdateProfileRequest представляет запрос на заполнение ролевого профиля работодателя
+// или университета. Набор обязательных полей зависит от роли пользователя и проверяется в сервисе.
+type UpdateProfileRequest struct {
+	// Профиль работодателя
+	BIN          string `json:"bin,omitempty" example:"180840012345"`
+	CompanyName  string `json:"companyName,omitempty" example:"ТОО Компания"`
+	CompanyEmail string `json:"companyEmail,omitempty" example:"hr@company.kz"`
+
+	// Профиль университета
+	UniversityName  string `json:"universityName,omitempty" example:"КазНУ им. аль-Фараби"`
+	UniversityEmail string `json:"universityEmail,omitempty" example:"career@kaznu.kz"`
+
+	// Общее поле для работодателя и университета
+	ContactPhone string `json:"contactPhone,omitempty" example:"+77271234567"`
+}
@@ -9,12 +9,20 @@ import (
 
 // UserResponse представляет ответ с данными пользователя
 type UserResponse struct {
-	ID        uuid.UUID       `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	Email     string          `json:"email" example:"user@example.com"`
-	Role      models.UserRole `json:"role" example:"student"`
-	IsActive  bool            `json:"is_active" example:"true"`
-	CreatedAt time.Time       `json:"created_at" example:"2024-01-15T10:30:00Z"`
-	UpdatedAt time.Time       `json:"updated_at" example:"2024-01-15T10:30:00Z"`
+	ID            uuid.UUID             `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Email         string                `json:"email" example:"user@example.com"`
+	Role          models.UserRole       `json:"role" example:"student"`
+	IsActive      bool                  `json:"is_active" example:"true"`
+	EmailVerified bool                  `json:"email_verified" example:"true"`
+	Approval      models.ApprovalStatus `json:"approval_status" example:"approved"`
+	CreatedAt     time.Time             `json:"created_at" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt     time.Time             `json:"updated_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// ProfileResponse представляет данные пользователя вместе с ролевым профилем
+type ProfileResponse struct {
+	UserResponse
+	Profile interface{} `json:"profile,omitempty"`
 }
 
 // TokenResponse представляет ответ с JWT токенами
@@ -25,10 +33,146 @@ type TokenResponse struct {
 	ExpiresIn    int64  `json:"expires_in" example:"86400"`
 }
 
-// AuthResponse представляет полный ответ после аутентификации
+// AuthResponse представляет полный ответ после аутентификации.
+// Если для входа нужен второй фактор, вместо токенов возвращается MFA.
 type AuthResponse struct {
-	User   UserResponse  `json:"user"`
-	Tokens TokenResponse `json:"tokens"`
+	User          UserResponse          `json:"user"`
+	Tokens        *TokenResponse        `json:"tokens,omitempty"`
+	MFA           *MFAChallengeResponse `json:"mfa,omitempty"`
+	RecoveryCodes []string              `json:"recovery_codes,omitempty"` // Выдаются один раз при подключении второго фактора
+}
+
+// Состояния второго шага входа
+const (
+	MFAStatusRequired      = "mfa_required"       // Введите код из приложения или код восстановления
+	MFAStatusSetupRequired = "mfa_setup_required" // Роль требует второй фактор, но он ещё не подключён
+)
+
+// MFAChallengeResponse представляет незавершённый вход, ожидающий код второго фактора
+type MFAChallengeResponse struct {
+	Status         string `json:"status" example:"mfa_required"`
+	ChallengeToken string `json:"challenge_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	ExpiresIn      int64  `json:"expires_in" example:"300"`
+}
+
+// MFASetupResponse представляет данные для добавления учётной записи в приложение-аутентификатор
+type MFASetupResponse struct {
+	Secret     string `json:"secret" example:"JBSWY3DPEHPK3PXPJBSWY3DPEHPK3PXP"`
+	OTPAuthURI string `json:"otpauth_uri" example:"otpauth://totp/Student%20Employment:user@example.com?secret=JBSWY3DPEHPK3PXP&issuer=Student+Employment"`
+}
+
+// MFAStatusResponse представляет состояние второго фактора пользователя
+type MFAStatusResponse struct {
+	Enabled           bool  `json:"enabled" example:"true"`
+	Pending           bool  `json:"pending" example:"false"` // Подключение начато, но не подтверждено кодом
+	Required          bool  `json:"required" example:"true"` // Второй фактор обязателен для роли пользователя
+	RecoveryCodesLeft int64 `json:"recovery_codes_left" example:"8"`
+}
+
+// MFARecoveryCodesResponse представляет новые коды восстановления (показываются один раз)
+type MFARecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes" example:"k7m2-x9qp,a3fd-8wzn"`
+}
+
+// MFARolePolicyResponse представляет требование второго фактора для роли
+type MFARolePolicyResponse struct {
+	Role     models.UserRole `json:"role" example:"employer"`
+	Required bool            `json:"required" example:"true"`
+}
+
+// OIDCProviderResponse представляет провайдера входа (SSO университета)
+type OIDCProviderResponse struct {
+	Name        string `json:"name" example:"kaznu"`
+	DisplayName string `json:"display_name" example:"КазНУ им. аль-Фараби"`
+}
+
+// SessionResponse представляет сеанс входа пользователя
+type SessionResponse struct {
+	ID         uuid.UUID `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Device     string    `json:"device" example:"Chrome, Windows"`
+	IPAddress  string    `json:"ip_address" example:"192.168.1.10"`
+	UserAgent  string    `json:"user_agent" example:"Mozilla/5.0 (Windows NT 10.0; Win64; x64)..."`
+	Current    bool      `json:"current" example:"true"`
+	CreatedAt  time.Time `json:"created_at" example:"2024-01-15T10:30:00Z"`
+	LastUsedAt time.Time `json:"last_used_at" example:"2024-01-16T08:00:00Z"`
+}
+
+// Причины недействительности access токена
+const (
+	TokenStatusUserNotFound   = "user_not_found"
+	TokenStatusUserInactive   = "user_inactive"
+	TokenStatusSessionRevoked = "session_revoked"
+	TokenStatusNoSession      = "no_session"
+)
+
+// TokenStatusResponse представляет результат проверки отзыва access токена
+type TokenStatusResponse struct {
+	Active bool   `json:"active" example:"true"`
+	Reason string `json:"reason,omitempty" example:"session_revoked"`
+}
+
+// LockoutEventResponse представляет запись журнала блокировок входа
+type LockoutEventResponse struct {
+	ID          uuid.UUID            `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Action      models.LockoutAction `json:"action" example:"locked"`
+	Scope       models.LockoutScope  `json:"scope" example:"account"`
+	Subject     string               `json:"subject" example:"user@example.com"`
+	Failures    int                  `json:"failures,omitempty" example:"5"`
+	LockedUntil *time.Time           `json:"locked_until,omitempty" example:"2024-01-15T10:45:00Z"`
+	ActorID     *uuid.UUID           `json:"actor_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440001"`
+	CreatedAt   time.Time            `json:"created_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// LockoutEventListResponse представляет страницу журнала блокировок
+type LockoutEventListResponse struct {
+	Items []LockoutEventResponse `json:"items"`
+	Total int64                  `json:"total" example:"42"`
+	Page  int                    `json:"page" example:"1"`
+	Limit int                    `json:"limit" example:"50"`
+}
+
+// UserListResponse представляет страницу списка пользователей
+type UserListResponse struct {
+	Items []UserResponse `json:"items"`
+	Total int64          `json:"total" example:"120"`
+	Page  int            `json:"page" example:"1"`
+	Limit int            `json:"limit" example:"50"`
+}
+
+// AdminUserResponse представляет подробные сведения о пользователе для администратора
+type AdminUserResponse struct {
+	UserResponse
+	MFAEnabled     bool     `json:"mfa_enabled" example:"false"`
+	ActiveSessions int      `json:"active_sessions" example:"2"`
+	OIDCProviders  []string `json:"oidc_providers" example:"kaznu"`
+}
+
+// InvitationResponse представляет отправленное приглашение
+type InvitationResponse struct {
+	ID        uuid.UUID       `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Email     string          `json:"email" example:"career@kaznu.kz"`
+	Role      models.UserRole `json:"role" example:"university"`
+	ExpiresAt time.Time       `json:"expires_at" example:"2024-01-18T10:30:00Z"`
+	CreatedAt time.Time       `json:"created_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// AdminAuditEventResponse представляет запись журнала действий администраторов
+type AdminAuditEventResponse struct {
+	ID           uuid.UUID          `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ActorID      uuid.UUID          `json:"actor_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	TargetUserID uuid.UUID          `json:"target_user_id" example:"550e8400-e29b-41d4-a716-446655440002"`
+	Action       models.AdminAction `json:"action" example:"role_changed"`
+	Details      map[string]string  `json:"details,omitempty"`
+	Reason       string             `json:"reason,omitempty" example:"Ошибка при регистрации"`
+	CreatedAt    time.Time          `json:"created_at" example:"2024-01-15T10:30:00Z"`
+}
+
+// AdminAuditEventListResponse представляет страницу журнала действий администраторов
+type AdminAuditEventListResponse struct {
+	Items []AdminAuditEventResponse `json:"items"`
+	Total int64                     `json:"total" example:"42"`
+	Page  int                       `json:"page" example:"1"`
+	Limit int                       `json:"limit" example:"50"`
 }
 
 // ErrorResponse представляет ответ с ошибкой
@@ -46,11 +190,61 @@ type SuccessResponse struct {
 // ToUserResponse преобразует модель User в UserResponse
 func ToUserResponse(user *models.User) UserResponse {
 	return UserResponse{
-		ID:        user.ID,
-		Email:     user.Email,
-		Role:      user.Role,
-		IsActive:  user.IsActive,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:            user.ID,
+		Email:         user.Email,
+		Role:          user.Role,
+		IsActive:      user.IsActive,
+		EmailVerified: user.EmailVerified,
+		Approval:      user.ApprovalStatus,
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
+	}
+}
+
+// ToProfileResponse объединяет модель User и ролевой профиль в ProfileResponse
+func ToProfileResponse(user *models.User, profile interface{}) ProfileResponse {
+	return ProfileResponse{
+		UserResponse: ToUserResponse(user),
+		Profile:      profile,
+	}
+}
+
+// ToSessionResponse преобразует модель Session в SessionResponse
+func ToSessionResponse(session *models.Session, currentSessionID uuid.UUID) SessionResponse {
+	return SessionResponse{
+		ID:         session.ID,
+		Device:     session.Device,
+		IPAddress:  session.IPAddress,
+		UserAgent:  session.UserAgent,
+		Current:    session.ID == currentSessionID,
+		CreatedAt:  session.CreatedAt,
+		LastUsedAt: session.LastUsedAt,
+	}
+}
+
+// ToLockoutEventResponse преобразует модель LockoutEvent в LockoutEventResponse
+func ToLockoutEventResponse(event *models.LockoutEvent) LockoutEventResponse {
+	return LockoutEventResponse{
+		ID:          event.ID,
+		Action:      event.Action,
+		Scope:       event.Scope,
+		Subject:     event.Subject,
+		Failures:    event.Failures,
+		LockedUntil: event.LockedUntil,
+		ActorID:     event.ActorID,
+		CreatedAt:   event.CreatedAt,
+	}
+}
+
+// ToAdminAuditEventResponse преобразует модель AdminAuditEvent в AdminAuditEventResponse
+func ToAdminAuditEventResponse(event *models.AdminAuditEvent) AdminAuditEventResponse {
+	return AdminAuditEventResponse{
+		ID:           event.ID,
+		ActorID:      event.ActorID,
+		TargetUserID: event.TargetUserID,
+		Action:       event.Action,
+		Details:      event.Details,
+		Reason:       event.Reason,
+		CreatedAt:    event.CreatedAt,
 	}
 }
@@ -0,0 +1,575 @@
+package handler
+
+import (
+	"auth-service/internal/dto"
+	"auth-service/internal/models"
+	"auth-service/internal/service"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Параметры постраничного вывода по умолчанию
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 200
+)
+
+// AdminHandler обрабатывает административные HTTP запросы
+type AdminHandler struct {
+	authService service.AuthService
+}
+
+// NewAdminHandler создаёт новый экземпляр обработчика администрирования
+func NewAdminHandler(authService service.AuthService) *AdminHandler {
+	return &AdminHandler{
+		authService: authService,
+	}
+}
+
+// UnlockLogin снимает блокировку входа
+// @Summary Снятие блокировки входа
+// @Description Сбрасывает счётчики неудачных попыток и блокировку для email и (или) IP-адреса
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.UnlockLoginRequest true "Email и (или) IP-адрес"
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /auth/admin/lockouts/unlock [post]
+func (h *AdminHandler) UnlockLogin(c *gin.Context) {
+	actorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UnlockLoginRequest
+
+	// Парсинг и валидация запроса
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := h.authService.UnlockLogin(c.Request.Context(), actorID, &req); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Блокировка входа снята",
+	})
+}
+
+// ListLockoutEvents возвращает журнал блокировок входа
+// @Summary Журнал блокировок входа
+// @Description Возвращает события блокировки и разблокировки входа, новые первыми
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Номер страницы" default(1)
+// @Param limit query int false "Размер страницы" default(50)
+// @Success 200 {object} dto.LockoutEventListResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /auth/admin/lockouts [get]
+func (h *AdminHandler) ListLockoutEvents(c *gin.Context) {
+	page, limit := pagination(c)
+
+	response, err := h.authService.ListLockoutEvents(c.Request.Context(), page, limit)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListMFARolePolicies возвращает требования второго фактора по ролям
+// @Summary Требования второго фактора
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.MFARolePolicyResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /auth/admin/mfa/roles [get]
+func (h *AdminHandler) ListMFARolePolicies(c *gin.Context) {
+	response, err := h.authService.ListMFARolePolicies(c.Request.Context())
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SetMFARolePolicy делает второй фактор обязательным или необязательным для роли
+// @Summary Требование второго фактора для роли
+// @Description Пользователи роли без второго фактора подключат его при следующем входе
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param role path string true "Роль" Enums(student, employer, university, admin)
+// @Param request body dto.MFARolePolicyRequest true "Требование"
+// @Success 200 {object} dto.MFARolePolicyResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /auth/admin/mfa/roles/{role} [put]
+func (h *AdminHandler) SetMFARolePolicy(c *gin.Context) {
+	actorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.MFARolePolicyRequest
+
+	// Парсинг и валидация запроса
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response, err := h.authService.SetMFARolePolicy(c.Request.Context(), actorID, models.UserRole(c.Param("role")), &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListUsers возвращает страницу пользователей с поиском и фильтрами
+// @Summary Список пользователей
+// @Description Поиск по части email, фильтры по роли, активности и дате регистрации (новые первыми)
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param q query string false "Часть email"
+// @Param role query string false "Роль" Enums(student, employer, university, admin)
+// @Param approval_status query string false "Состояние одобрения" Enums(approved, pending, rejected)
+// @Param active query bool false "Активна ли учётная запись"
+// @Param created_from query string false "Дата регистрации с (YYYY-MM-DD)"
+// @Param created_to query string false "Дата регистрации по, включительно (YYYY-MM-DD)"
+// @Param page query int false "Номер страницы" default(1)
+// @Param limit query int false "Размер страницы" default(50)
+// @Success 200 {object} dto.UserListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /auth/admin/users [get]
+func (h *AdminHandler) ListUsers(c *gin.Context) {
+	var req dto.ListUsersRequest
+
+	// Парсинг и валидация фильтров
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page, limit := pagination(c)
+	response, err := h.authService.ListUsers(c.Request.Context(), &req, page, limit)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetUser возвращает подробные сведения о пользователе
+// @Summary Пользователь
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID пользователя"
+// @Success 200 {object} dto.AdminUserResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /auth/admin/users/{id} [get]
+func (h *AdminHandler) GetUser(c *gin.Context) {
+	userID, ok := userIDParam(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.authService.GetUser(c.Request.Context(), userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ActivateUser активирует учётную запись
+// @Summary Активация пользователя
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID пользователя"
+// @Param request body dto.AdminActionRequest false "Причина"
+// @Success 200 {object} dto.UserResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /auth/admin/users/{id}/activate [post]
+func (h *AdminHandler) ActivateUser(c *gin.Context) {
+	h.setUserActive(c, true)
+}
+
+// DeactivateUser деактивирует учётную запись и завершает её сеансы
+// @Summary Деактивация пользователя
+// @Description Вход становится невозможным, выданные токены перестают действовать немедленно
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID пользователя"
+// @Param request body dto.AdminActionRequest false "Причина"
+// @Success 200 {object} dto.UserResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /auth/admin/users/{id}/deactivate [post]
+func (h *AdminHandler) DeactivateUser(c *gin.Context) {
+	h.setUserActive(c, false)
+}
+
+// setUserActive обрабатывает активацию и деактивацию учётной записи
+func (h *AdminHandler) setUserActive(c *gin.Context, active bool) {
+	actorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	userID, ok := userIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.AdminActionRequest
+	if !bindOptionalJSON(c, &req) {
+		return
+	}
+
+	response, err := h.authService.SetUserActive(c.Request.Context(), actorID, userID, active, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ChangeUserRole меняет роль пользователя
+// @Summary Изменение роли
+// @Description Все сеансы пользователя завершаются, новая роль действует после входа
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID пользователя"
+// @Param request body dto.ChangeRoleRequest true "Новая роль и причина"
+// @Success 200 {object} dto.UserResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /auth/admin/users/{id}/role [put]
+func (h *AdminHandler) ChangeUserRole(c *gin.Context) {
+	actorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	userID, ok := userIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ChangeRoleRequest
+
+	// Парсинг и валидация запроса
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response, err := h.authService.ChangeUserRole(c.Request.Context(), actorID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ForcePasswordReset принудительно сбрасывает пароль пользователя
+// @Summary Принудительный сброс пароля
+// @Description Текущий пароль перестаёт действовать, сеансы завершаются, пользователю отправляется ссылка для установки нового пароля
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID пользователя"
+// @Param request body dto.AdminActionRequest false "Причина"
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /auth/admin/users/{id}/password-reset [post]
+func (h *AdminHandler) ForcePasswordReset(c *gin.Context) {
+	actorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	userID, ok := userIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.AdminActionRequest
+	if !bindOptionalJSON(c, &req) {
+		return
+	}
+
+	if err := h.authService.ForcePasswordReset(c.Request.Context(), actorID, userID, &req); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Пароль сброшен, пользователю отправлена ссылка для установки нового пароля",
+	})
+}
+
+// DeleteUser мягко удаляет учётную запись
+// @Summary Удаление пользователя
+// @Description Учётная запись скрывается и не может войти, email остаётся занятым
+// @Tags admin
+// @Accept json
+// @Security BearerAuth
+// @Param id path string true "ID пользователя"
+// @Param request body dto.AdminActionRequest false "Причина"
+// @Success 204
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /auth/admin/users/{id} [delete]
+func (h *AdminHandler) DeleteUser(c *gin.Context) {
+	actorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	userID, ok := userIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.AdminActionRequest
+	if !bindOptionalJSON(c, &req) {
+		return
+	}
+
+	if err := h.authService.DeleteUser(c.Request.Context(), actorID, userID, &req); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ApproveUser одобряет учётную запись, ожидающую проверки
+// @Summary Одобрение регистрации
+// @Description Пользователь получает письмо и может войти
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID пользователя"
+// @Param request body dto.AdminActionRequest false "Причина"
+// @Success 200 {object} dto.UserResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /auth/admin/users/{id}/approve [post]
+func (h *AdminHandler) ApproveUser(c *gin.Context) {
+	h.reviewUser(c, h.authService.ApproveUser)
+}
+
+// RejectUser отклоняет учётную запись, ожидающую проверки
+// @Summary Отклонение регистрации
+// @Description Пользователь получает письмо с причиной; вход остаётся невозможным
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "ID пользователя"
+// @Param request body dto.AdminActionRequest false "Причина"
+// @Success 200 {object} dto.UserResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /auth/admin/users/{id}/reject [post]
+func (h *AdminHandler) RejectUser(c *gin.Context) {
+	h.reviewUser(c, h.authService.RejectUser)
+}
+
+// reviewUser обрабатывает одобрение и отклонение регистрации
+func (h *AdminHandler) reviewUser(c *gin.Context, review func(ctx context.Context, actorID, userID uuid.UUID, req *dto.AdminActionRequest) (*dto.UserResponse, error)) {
+	actorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	userID, ok := userIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.AdminActionRequest
+	if !bindOptionalJSON(c, &req) {
+		return
+	}
+
+	response, err := review(c.Request.Context(), actorID, userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateInvitation отправляет приглашение зарегистрироваться с указанной ролью
+// @Summary Приглашение пользователя
+// @Description Единственный способ зарегистрировать университет без одобрения или нового администратора
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.InvitationRequest true "Email и роль"
+// @Success 201 {object} dto.InvitationResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /auth/admin/invitations [post]
+func (h *AdminHandler) CreateInvitation(c *gin.Context) {
+	actorID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.InvitationRequest
+
+	// Парсинг и валидация запроса
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response, err := h.authService.CreateInvitation(c.Request.Context(), actorID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// ListAdminAuditEvents возвращает журнал действий администраторов
+// @Summary Журнал действий администраторов
+// @Tags admin
+// @Produce json
+// @Security BearerAuth
+// @Param user_id query string false "ID пользователя, над которым выполнялись действия"
+// @Param page query int false "Номер страницы" default(1)
+// @Param limit query int false "Размер страницы" default(50)
+// @Success 200 {object} dto.AdminAuditEventListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /auth/admin/audit [get]
+func (h *AdminHandler) ListAdminAuditEvents(c *gin.Context) {
+	targetUserID := uuid.Nil
+	if value := c.Query("user_id"); value != "" {
+		parsed, err := uuid.Parse(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Ошибка валидации",
+				Message: "Некорректный ID пользователя",
+			})
+			return
+		}
+		targetUserID = parsed
+	}
+
+	page, limit := pagination(c)
+	response, err := h.authService.ListAdminAuditEvents(c.Request.Context(), targetUserID, page, limit)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// userIDParam читает ID пользователя из пути и отвечает 400, если он некорректен
+func userIDParam(c *gin.Context) (uuid.UUID, bool) {
+	userID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: "Некорректный ID пользователя",
+		})
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// bindOptionalJSON разбирает необязательное тело запроса; пустое тело допустимо
+func bindOptionalJSON(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return false
+	}
+	return true
+}
+
+// pagination читает параметры page и limit, подставляя значения по умолчанию
+func pagination(c *gin.Context) (page, limit int) {
+	page, err := strconv.Atoi(c.Query("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	limit, err = strconv.Atoi(c.Query("limit"))
+	if err != nil || limit < 1 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+
+	return page, limit
+}
@@ -2,11 +2,16 @@ package handler
 
 import (
 	"auth-service/internal/dto"
+	"auth-service/internal/lockout"
+	"auth-service/internal/oidc"
 	"auth-service/internal/repository"
 	"auth-service/internal/service"
 	"auth-service/pkg/jwt"
 	"errors"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -26,13 +31,16 @@ func NewAuthHandler(authService service.AuthService) *AuthHandler {
 
 // Register обрабатывает запрос на регистрацию нового пользователя
 // @Summary Регистрация пользователя
-// @Description Создаёт нового пользователя и возвращает JWT токены
+// @Description Создаёт нового пользователя и возвращает JWT токены. Самостоятельно доступны роли student и employer;
+// @Description университет ожидает одобрения администратором (202 без токенов), другие роли - только по приглашению.
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param request body dto.RegisterRequest true "Данные регистрации"
 // @Success 201 {object} dto.AuthResponse
+// @Success 202 {object} dto.AuthResponse
 // @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
 // @Failure 409 {object} dto.ErrorResponse
 // @Router /auth/register [post]
 func (h *AuthHandler) Register(c *gin.Context) {
@@ -48,12 +56,18 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	}
 
 	// Вызов сервиса регистрации
-	response, err := h.authService.Register(&req)
+	response, err := h.authService.Register(c.Request.Context(), &req, clientInfo(c))
 	if err != nil {
 		handleServiceError(c, err)
 		return
 	}
 
+	// Учётная запись создана, но вход возможен только после одобрения
+	if response.Tokens == nil {
+		c.JSON(http.StatusAccepted, response)
+		return
+	}
+
 	c.JSON(http.StatusCreated, response)
 }
 
@@ -81,7 +95,7 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	}
 
 	// Вызов сервиса аутентификации
-	response, err := h.authService.Login(&req)
+	response, err := h.authService.Login(c.Request.Context(), &req, clientInfo(c))
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -101,28 +115,76 @@ func (h *AuthHandler) Login(c *gin.Context) {
 // @Failure 404 {object} dto.ErrorResponse
 // @Router /auth/me [get]
 func (h *AuthHandler) GetProfile(c *gin.Context) {
-	// Получение ID пользователя из контекста (установлен middleware)
-	userID, exists := c.Get("user_id")
-	if !exists {
-		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
-			Error:   "Не авторизован",
-			Message: "Требуется аутентификация",
-		})
+	id, ok := currentUserID(c)
+	if !ok {
 		return
 	}
 
-	// Преобразование ID в UUID
-	id, ok := userID.(uuid.UUID)
+	// Получение профиля
+	response, err := h.authService.GetProfile(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetRoleProfile возвращает данные пользователя вместе с ролевым профилем
+// @Summary Получение ролевого профиля
+// @Description Возвращает данные пользователя и профиль работодателя или университета
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.ProfileResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /auth/profile [get]
+func (h *AuthHandler) GetRoleProfile(c *gin.Context) {
+	id, ok := currentUserID(c)
 	if !ok {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
-			Error:   "Внутренняя ошибка",
-			Message: "Некорректный формат ID пользователя",
+		return
+	}
+
+	response, err := h.authService.GetRoleProfile(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// UpdateProfile создаёт или обновляет ролевой профиль текущего пользователя
+// @Summary Заполнение профиля
+// @Description Сохраняет профиль в соответствии с ролью пользователя и возвращает данные пользователя вместе с профилем
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.UpdateProfileRequest true "Данные профиля"
+// @Success 200 {object} dto.ProfileResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /auth/profile [put]
+func (h *AuthHandler) UpdateProfile(c *gin.Context) {
+	id, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.UpdateProfileRequest
+
+	// Парсинг запроса (набор обязательных полей проверяется сервисом по роли)
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
 		})
 		return
 	}
 
-	// Получение профиля
-	response, err := h.authService.GetProfile(id)
+	response, err := h.authService.UpdateProfile(c.Request.Context(), id, &req)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -155,18 +217,430 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	}
 
 	// Вызов сервиса обновления токена
-	response, err := h.authService.RefreshToken(req.RefreshToken)
+	response, err := h.authService.RefreshToken(c.Request.Context(), req.RefreshToken, clientInfo(c))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Logout завершает текущий сеанс пользователя
+// @Summary Выход из системы
+// @Description Отзывает refresh токены текущего сеанса
+// @Tags auth
+// @Security BearerAuth
+// @Success 204
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /auth/logout [post]
+func (h *AuthHandler) Logout(c *gin.Context) {
+	id, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.authService.Logout(c.Request.Context(), id, currentSessionID(c)); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// ListSessions возвращает действующие сеансы текущего пользователя
+// @Summary Список сеансов
+// @Description Возвращает устройства, на которых выполнен вход, с отметкой текущего сеанса
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.SessionResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /auth/sessions [get]
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	id, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.authService.ListSessions(c.Request.Context(), id, currentSessionID(c))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RevokeSession завершает выбранный сеанс текущего пользователя
+// @Summary Завершение сеанса
+// @Description Отзывает refresh токены указанного сеанса
+// @Tags auth
+// @Security BearerAuth
+// @Param id path string true "ID сеанса"
+// @Success 204
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /auth/sessions/{id} [delete]
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	id, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	sessionID, err := uuid.Parse(c.Param("id"))
 	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: "Некорректный ID сеанса",
+		})
+		return
+	}
+
+	if err := h.authService.RevokeSession(c.Request.Context(), id, sessionID); err != nil {
 		handleServiceError(c, err)
 		return
 	}
 
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeAllSessions завершает все сеансы текущего пользователя
+// @Summary Выход на всех устройствах
+// @Description Отзывает refresh токены всех сеансов пользователя, включая текущий
+// @Tags auth
+// @Security BearerAuth
+// @Success 204
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /auth/sessions [delete]
+func (h *AuthHandler) RevokeAllSessions(c *gin.Context) {
+	id, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.authService.RevokeAllSessions(c.Request.Context(), id); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// VerifyEmail подтверждает email по токену из письма
+// @Summary Подтверждение email
+// @Description Подтверждает email пользователя по одноразовому токену из письма
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.VerifyEmailRequest true "Токен из письма"
+// @Success 200 {object} dto.UserResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Router /auth/verify-email [post]
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	var req dto.VerifyEmailRequest
+
+	// Парсинг и валидация запроса
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response, err := h.authService.VerifyEmail(c.Request.Context(), req.Token)
+	if err != nil {
+		handleVerificationError(c, err)
+		return
+	}
+
 	c.JSON(http.StatusOK, response)
 }
 
+// ResendVerification повторно отправляет письмо с подтверждением email
+// @Summary Повторная отправка подтверждения
+// @Description Отправляет новую ссылку подтверждения email; предыдущие ссылки перестают действовать
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Router /auth/verify-email/resend [post]
+func (h *AuthHandler) ResendVerification(c *gin.Context) {
+	id, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.authService.ResendVerification(c.Request.Context(), id); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Письмо с подтверждением отправлено",
+	})
+}
+
+// ForgotPassword отправляет ссылку для сброса пароля
+// @Summary Запрос сброса пароля
+// @Description Отправляет ссылку для сброса пароля. Ответ одинаков независимо от того, зарегистрирован ли email
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.ForgotPasswordRequest true "Email пользователя"
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Router /auth/password/forgot [post]
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req dto.ForgotPasswordRequest
+
+	// Парсинг и валидация запроса
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := h.authService.ForgotPassword(c.Request.Context(), &req); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Если email зарегистрирован, на него отправлена ссылка для сброса пароля",
+	})
+}
+
+// ResetPassword устанавливает новый пароль по ссылке из письма
+// @Summary Сброс пароля
+// @Description Устанавливает новый пароль по одноразовому токену и завершает все сеансы пользователя
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body dto.ResetPasswordRequest true "Токен и новый пароль"
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Router /auth/password/reset [post]
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var req dto.ResetPasswordRequest
+
+	// Парсинг и валидация запроса
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := h.authService.ResetPassword(c.Request.Context(), &req); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Пароль изменён, выполните вход с новым паролем",
+	})
+}
+
+// ChangePassword меняет пароль текущего пользователя
+// @Summary Смена пароля
+// @Description Меняет пароль после проверки текущего; остальные сеансы пользователя завершаются
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.ChangePasswordRequest true "Текущий и новый пароль"
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /auth/password/change [post]
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	id, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ChangePasswordRequest
+
+	// Парсинг и валидация запроса
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := h.authService.ChangePassword(c.Request.Context(), id, currentSessionID(c), &req); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Пароль изменён, остальные сеансы завершены",
+	})
+}
+
+// GetTokenStatus сообщает, не отозван ли access токен (внутренний эндпоинт для API Gateway)
+// @Summary Проверка отзыва токена
+// @Description Проверяет, что пользователь активен и сеанс токена не завершён
+// @Tags internal
+// @Produce json
+// @Param user_id query string true "ID пользователя"
+// @Param session_id query string false "ID сеанса"
+// @Success 200 {object} dto.TokenStatusResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Router /internal/tokens/status [get]
+func (h *AuthHandler) GetTokenStatus(c *gin.Context) {
+	userID, err := uuid.Parse(c.Query("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: "Некорректный ID пользователя",
+		})
+		return
+	}
+
+	sessionID := uuid.Nil
+	if raw := c.Query("session_id"); raw != "" {
+		if sessionID, err = uuid.Parse(raw); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "Ошибка валидации",
+				Message: "Некорректный ID сеанса",
+			})
+			return
+		}
+	}
+
+	response, err := h.authService.GetTokenStatus(c.Request.Context(), userID, sessionID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// currentUserID извлекает ID пользователя из контекста (установлен middleware).
+// При ошибке ответ уже отправлен клиенту и возвращается false.
+func currentUserID(c *gin.Context) (uuid.UUID, bool) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "Не авторизован",
+			Message: "Требуется аутентификация",
+		})
+		return uuid.Nil, false
+	}
+
+	// Преобразование ID в UUID
+	id, ok := userID.(uuid.UUID)
+	if !ok {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Внутренняя ошибка",
+			Message: "Некорректный формат ID пользователя",
+		})
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+// currentSessionID возвращает ID сеанса из access токена.
+// Для токенов, выданных до появления сеансов, возвращается uuid.Nil.
+func currentSessionID(c *gin.Context) uuid.UUID {
+	sessionID, _ := c.Get("session_id")
+	id, _ := sessionID.(uuid.UUID)
+	return id
+}
+
+// clientInfo собирает сведения о клиенте для учёта сеансов
+func clientInfo(c *gin.Context) dto.ClientInfo {
+	return dto.ClientInfo{
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+}
+
+// handleVerificationError обрабатывает ошибки подтверждения email:
+// любая проблема со ссылкой возвращается как 400, а не 401
+func handleVerificationError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, jwt.ErrExpiredToken):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка подтверждения",
+			Message: "Срок действия ссылки истёк, запросите новое письмо",
+		})
+	case errors.Is(err, service.ErrInvalidResetToken):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка сброса пароля",
+			Message: "Ссылка для сброса пароля недействительна или устарела",
+		})
+	case errors.Is(err, service.ErrWrongPassword):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: "Неверный текущий пароль",
+			Details: map[string]string{"current_password": "Неверный текущий пароль"},
+		})
+	case errors.Is(err, service.ErrSamePassword):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: "Новый пароль должен отличаться от текущего",
+			Details: map[string]string{"new_password": "Новый пароль должен отличаться от текущего"},
+		})
+	case errors.Is(err, service.ErrEmailAlreadyVerified):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Email уже подтверждён",
+		})
+	case errors.Is(err, service.ErrVerificationTooSoon):
+		c.JSON(http.StatusTooManyRequests, dto.ErrorResponse{
+			Error:   "Слишком много запросов",
+			Message: "Письмо уже отправлено, повторите попытку через минуту",
+		})
+	case errors.Is(err, jwt.ErrInvalidToken):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка подтверждения",
+			Message: "Недействительная ссылка подтверждения",
+		})
+	case errors.Is(err, repository.ErrUserTokenUsed):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка подтверждения",
+			Message: "Ссылка уже использована или заменена новой",
+		})
+	default:
+		handleServiceError(c, err)
+	}
+}
+
 // handleServiceError обрабатывает ошибки сервиса и возвращает соответствующий HTTP ответ
 func handleServiceError(c *gin.Context, err error) {
+	var validationErr *service.ValidationError
+	var lockedErr *lockout.LockedError
+
 	switch {
+	case errors.As(err, &lockedErr):
+		// Округление вверх, чтобы клиент не повторил запрос раньше снятия блокировки
+		retryAfter := int(math.Ceil(lockedErr.RetryAfter.Seconds()))
+		c.Header("Retry-After", strconv.Itoa(retryAfter))
+		c.JSON(http.StatusTooManyRequests, dto.ErrorResponse{
+			Error:   "Слишком много попыток",
+			Message: fmt.Sprintf("Слишком много неудачных попыток входа, повторите через %d с", retryAfter),
+		})
+	case errors.As(err, &validationErr):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: "Проверьте правильность заполнения полей",
+			Details: validationErr.Details,
+		})
 	case errors.Is(err, repository.ErrUserAlreadyExists):
 		c.JSON(http.StatusConflict, dto.ErrorResponse{
 			Error:   "Конфликт",
@@ -192,6 +666,112 @@ func handleServiceError(c *gin.Context, err error) {
 			Error:   "Ошибка валидации",
 			Message: "Недопустимая роль пользователя",
 		})
+	case errors.Is(err, service.ErrProfileNotSupported):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: "Для этой роли профиль не предусмотрен",
+		})
+	case errors.Is(err, repository.ErrSessionNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Не найдено",
+			Message: "Сеанс не найден",
+		})
+	case errors.Is(err, service.ErrRefreshTokenReused),
+		errors.Is(err, service.ErrRefreshTokenRevoked),
+		errors.Is(err, service.ErrSessionNotActive):
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "Ошибка аутентификации",
+			Message: "Сессия завершена, выполните вход повторно",
+		})
+	case errors.Is(err, service.ErrInvalidMFACode):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: "Неверный код подтверждения",
+			Details: map[string]string{"code": "Неверный или уже использованный код"},
+		})
+	case errors.Is(err, service.ErrWrongPassword):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: "Неверный пароль",
+			Details: map[string]string{"password": "Неверный пароль"},
+		})
+	case errors.Is(err, service.ErrMFAChallengeInvalid):
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "Ошибка аутентификации",
+			Message: "Время на ввод кода истекло, выполните вход повторно",
+		})
+	case errors.Is(err, service.ErrMFANotAvailable):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "Доступ запрещён",
+			Message: "Двухфакторная аутентификация недоступна для этой роли",
+		})
+	case errors.Is(err, service.ErrMFAAlreadyEnabled):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Двухфакторная аутентификация уже подключена",
+		})
+	case errors.Is(err, service.ErrMFANotEnabled):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Двухфакторная аутентификация не подключена",
+		})
+	case errors.Is(err, service.ErrMFASetupNotStarted):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Сначала начните подключение двухфакторной аутентификации",
+		})
+	case errors.Is(err, service.ErrMFARequired):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Двухфакторная аутентификация обязательна для вашей роли",
+		})
+	case errors.Is(err, service.ErrRoleNotSelfRegistrable):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "Доступ запрещён",
+			Message: "Эта роль доступна только по приглашению администратора",
+			Details: map[string]string{"role": "Роль недоступна для самостоятельной регистрации"},
+		})
+	case errors.Is(err, service.ErrInvalidInvitation):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: "Приглашение недействительно или устарело",
+			Details: map[string]string{"invitation_token": "Приглашение недействительно или устарело"},
+		})
+	case errors.Is(err, service.ErrAccountPendingApproval):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "Доступ запрещён",
+			Message: "Учётная запись ожидает одобрения администратором",
+		})
+	case errors.Is(err, service.ErrAccountRejected):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "Доступ запрещён",
+			Message: "Регистрация отклонена администратором",
+		})
+	case errors.Is(err, service.ErrNotPendingApproval):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Учётная запись не ожидает одобрения",
+		})
+	case errors.Is(err, service.ErrCannotModifySelf):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Нельзя деактивировать, удалить или изменить роль собственной учётной записи",
+		})
+	case errors.Is(err, service.ErrOIDCProviderNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Не найдено",
+			Message: "Провайдер входа не найден",
+		})
+	case errors.Is(err, service.ErrInvalidOIDCLoginCode):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка аутентификации",
+			Message: "Код входа недействителен или устарел, выполните вход повторно",
+		})
+	case errors.Is(err, oidc.ErrProviderUnavailable):
+		c.JSON(http.StatusServiceUnavailable, dto.ErrorResponse{
+			Error:   "Сервис недоступен",
+			Message: "Провайдер входа временно недоступен",
+		})
 	case errors.Is(err, jwt.ErrInvalidToken):
 		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
 			Error:   "Ошибка аутентификации",
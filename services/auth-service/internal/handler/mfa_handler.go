@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"auth-service/internal/dto"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VerifyMFALogin завершает вход кодом второго фактора
+// @Summary Второй шаг входа
+// @Description Принимает токен второго шага из ответа /auth/login и код из приложения (или код восстановления), возвращает JWT токены
+// @Tags mfa
+// @Accept json
+// @Produce json
+// @Param request body dto.MFALoginRequest true "Токен второго шага и код"
+// @Success 200 {object} dto.AuthResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 429 {object} dto.ErrorResponse
+// @Router /auth/login/mfa [post]
+func (h *AuthHandler) VerifyMFALogin(c *gin.Context) {
+	var req dto.MFALoginRequest
+
+	// Парсинг и валидация запроса
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response, err := h.authService.VerifyMFALogin(c.Request.Context(), &req, clientInfo(c))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SetupMFAForLogin начинает обязательное подключение второго фактора при входе
+// @Summary Подключение второго фактора при входе
+// @Description Для ролей с обязательным вторым фактором: возвращает секрет и otpauth URI; вход завершается через /auth/login/mfa
+// @Tags mfa
+// @Accept json
+// @Produce json
+// @Param request body dto.MFAChallengeRequest true "Токен второго шага"
+// @Success 200 {object} dto.MFASetupResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /auth/login/mfa/setup [post]
+func (h *AuthHandler) SetupMFAForLogin(c *gin.Context) {
+	var req dto.MFAChallengeRequest
+
+	// Парсинг и валидация запроса
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response, err := h.authService.SetupMFAForLogin(c.Request.Context(), &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetMFAStatus возвращает состояние второго фактора текущего пользователя
+// @Summary Состояние второго фактора
+// @Tags mfa
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.MFAStatusResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /auth/mfa [get]
+func (h *AuthHandler) GetMFAStatus(c *gin.Context) {
+	id, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.authService.GetMFAStatus(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// SetupMFA начинает подключение второго фактора
+// @Summary Подключение второго фактора
+// @Description Возвращает секрет и otpauth URI для приложения-аутентификатора; подключение завершается через /auth/mfa/confirm
+// @Tags mfa
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.MFASetupResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /auth/mfa/setup [post]
+func (h *AuthHandler) SetupMFA(c *gin.Context) {
+	id, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.authService.SetupMFA(c.Request.Context(), id)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ConfirmMFA подтверждает подключение второго фактора первым кодом
+// @Summary Подтверждение второго фактора
+// @Description Включает второй фактор и возвращает коды восстановления (показываются один раз)
+// @Tags mfa
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.MFACodeRequest true "Код из приложения"
+// @Success 200 {object} dto.MFARecoveryCodesResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /auth/mfa/confirm [post]
+func (h *AuthHandler) ConfirmMFA(c *gin.Context) {
+	id, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.MFACodeRequest
+
+	// Парсинг и валидация запроса
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response, err := h.authService.ConfirmMFA(c.Request.Context(), id, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// RegenerateRecoveryCodes выдаёт новые коды восстановления
+// @Summary Новые коды восстановления
+// @Description Заменяет все коды восстановления новыми; требуется код из приложения
+// @Tags mfa
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.MFACodeRequest true "Код из приложения"
+// @Success 200 {object} dto.MFARecoveryCodesResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /auth/mfa/recovery-codes [post]
+func (h *AuthHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	id, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.MFACodeRequest
+
+	// Парсинг и валидация запроса
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response, err := h.authService.RegenerateRecoveryCodes(c.Request.Context(), id, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// DisableMFA отключает второй фактор
+// @Summary Отключение второго фактора
+// @Description Требует пароль и код из приложения; недоступно, если второй фактор обязателен для роли
+// @Tags mfa
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.DisableMFARequest true "Пароль и код из приложения"
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /auth/mfa/disable [post]
+func (h *AuthHandler) DisableMFA(c *gin.Context) {
+	id, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.DisableMFARequest
+
+	// Парсинг и валидация запроса
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if err := h.authService.DisableMFA(c.Request.Context(), id, &req); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Двухфакторная аутентификация отключена",
+	})
+}
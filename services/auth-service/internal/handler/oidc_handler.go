@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"auth-service/internal/dto"
+	"auth-service/internal/service"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Cookie, привязывающая вход через провайдера к браузеру, который его начал
+const (
+	oidcStateCookie     = "oidc_state"
+	oidcStateCookiePath = "/api/auth/oidc/"
+)
+
+// ListOIDCProviders возвращает провайдеров входа для кнопок на странице входа
+// @Summary Провайдеры входа
+// @Description Список настроенных провайдеров единого входа (SSO университетов)
+// @Tags oidc
+// @Produce json
+// @Success 200 {array} dto.OIDCProviderResponse
+// @Router /auth/oidc/providers [get]
+func (h *AuthHandler) ListOIDCProviders(c *gin.Context) {
+	c.JSON(http.StatusOK, h.authService.ListOIDCProviders())
+}
+
+// StartOIDCLogin перенаправляет пользователя на страницу входа провайдера
+// @Summary Вход через провайдера
+// @Description Перенаправляет на страницу входа провайдера (authorization code flow с PKCE)
+// @Tags oidc
+// @Param provider path string true "Имя провайдера"
+// @Success 302
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /auth/oidc/{provider}/login [get]
+func (h *AuthHandler) StartOIDCLogin(c *gin.Context) {
+	redirectURL, state, err := h.authService.StartOIDCLogin(c.Request.Context(), c.Param("provider"))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	// Lax: cookie отправляется при переходе на /callback со страницы провайдера
+	setOIDCStateCookie(c, state, int(service.OIDCStateTTL.Seconds()))
+	c.Redirect(http.StatusFound, redirectURL)
+}
+
+// CompleteOIDCLogin принимает пользователя от провайдера и перенаправляет в веб-приложение
+// @Summary Возврат от провайдера
+// @Description Проверяет ответ провайдера и перенаправляет на /oidc/callback веб-приложения с одноразовым кодом входа или кодом ошибки
+// @Tags oidc
+// @Param provider path string true "Имя провайдера"
+// @Param code query string false "Код авторизации"
+// @Param state query string false "Параметр state"
+// @Param error query string false "Ошибка провайдера"
+// @Success 302
+// @Router /auth/oidc/{provider}/callback [get]
+func (h *AuthHandler) CompleteOIDCLogin(c *gin.Context) {
+	var req dto.OIDCCallbackRequest
+	_ = c.ShouldBindQuery(&req)
+	req.BrowserState, _ = c.Cookie(oidcStateCookie)
+
+	// state одноразовый, поэтому cookie удаляется при любом исходе
+	setOIDCStateCookie(c, "", -1)
+
+	// Ошибка не показывается пользователю подробно: веб-приложение получает только её код
+	redirectURL, err := h.authService.CompleteOIDCLogin(c.Request.Context(), c.Param("provider"), req)
+	if err != nil {
+		slog.WarnContext(c.Request.Context(), "Ошибка входа через провайдера", "provider", c.Param("provider"), "error", err)
+	}
+
+	c.Redirect(http.StatusFound, redirectURL)
+}
+
+// ExchangeOIDCLoginCode обменивает одноразовый код входа на токены
+// @Summary Завершение входа через провайдера
+// @Description Принимает код из адреса /oidc/callback веб-приложения и возвращает JWT токены или второй шаг входа
+// @Tags oidc
+// @Accept json
+// @Produce json
+// @Param request body dto.OIDCExchangeRequest true "Код входа"
+// @Success 200 {object} dto.AuthResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /auth/oidc/exchange [post]
+func (h *AuthHandler) ExchangeOIDCLoginCode(c *gin.Context) {
+	var req dto.OIDCExchangeRequest
+
+	// Парсинг и валидация запроса
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response, err := h.authService.ExchangeOIDCLoginCode(c.Request.Context(), &req, clientInfo(c))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// setOIDCStateCookie устанавливает (или при maxAge < 0 удаляет) cookie со state входа через провайдера
+func setOIDCStateCookie(c *gin.Context, state string, maxAge int) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    state,
+		Path:     oidcStateCookiePath,
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
@@ -0,0 +1,193 @@
+package lockout
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// freeAttempts - число неудач по учётной записи без задержки (опечатки)
+	freeAttempts = 2
+	// baseDelay и maxDelay - границы прогрессивной задержки между попытками
+	baseDelay = time.Second
+	maxDelay  = 30 * time.Second
+)
+
+// LockedError возвращается, если вход временно запрещён
+type LockedError struct {
+	RetryAfter time.Duration
+}
+
+// Error реализует интерфейс error
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("слишком много неудачных попыток входа, повторите через %s", e.RetryAfter)
+}
+
+// Auditor сохраняет события журнала блокировок
+type Auditor interface {
+	Record(ctx context.Context, event *models.LockoutEvent) error
+}
+
+// Config содержит пороги защиты от перебора паролей
+type Config struct {
+	MaxAccountFailures int           // Неудач по учётной записи до блокировки
+	MaxIPFailures      int           // Неудач с одного IP-адреса до блокировки
+	Window             time.Duration // Окно, в котором копятся неудачи
+	LockDuration       time.Duration // Длительность блокировки
+}
+
+// Guard защищает вход от перебора паролей.
+// По учётной записи после нескольких неудач вводится прогрессивная задержка, затем временная блокировка.
+// По IP-адресу задержки нет (за одним адресом может быть целая сеть университета) - только блокировка
+// при большом числе неудач.
+type Guard struct {
+	store   Store
+	auditor Auditor
+	cfg     Config
+}
+
+// NewGuard создаёт защиту входа
+func NewGuard(store Store, auditor Auditor, cfg Config) *Guard {
+	return &Guard{store: store, auditor: auditor, cfg: cfg}
+}
+
+// Check возвращает *LockedError, если вход для учётной записи или IP-адреса временно запрещён
+func (g *Guard) Check(ctx context.Context, email, ip string) error {
+	now := time.Now()
+	var retryAfter time.Duration
+
+	for _, key := range []string{accountKey(email), ipKey(ip)} {
+		until, err := g.store.LockedUntil(ctx, key)
+		if err != nil {
+			return err
+		}
+		if wait := until.Sub(now); wait > retryAfter {
+			retryAfter = wait
+		}
+	}
+
+	if retryAfter > 0 {
+		return &LockedError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// RegisterFailure учитывает неудачную попытку входа
+func (g *Guard) RegisterFailure(ctx context.Context, email, ip string) error {
+	now := time.Now()
+
+	// Учётная запись: прогрессивная задержка, затем блокировка
+	failures, err := g.store.Increment(ctx, accountKey(email), g.cfg.Window)
+	if err != nil {
+		return err
+	}
+	if failures >= g.cfg.MaxAccountFailures {
+		if err := g.lock(ctx, models.LockoutScopeAccount, normalizeEmail(email), accountKey(email), failures, now); err != nil {
+			return err
+		}
+	} else if delay := progressiveDelay(failures); delay > 0 {
+		if err := g.store.Lock(ctx, accountKey(email), now.Add(delay)); err != nil {
+			return err
+		}
+	}
+
+	// IP-адрес: только блокировка после большого числа неудач
+	if ip == "" {
+		return nil
+	}
+	failures, err = g.store.Increment(ctx, ipKey(ip), g.cfg.Window)
+	if err != nil {
+		return err
+	}
+	if failures >= g.cfg.MaxIPFailures {
+		return g.lock(ctx, models.LockoutScopeIP, ip, ipKey(ip), failures, now)
+	}
+	return nil
+}
+
+// RegisterSuccess сбрасывает счётчик учётной записи после успешного входа
+func (g *Guard) RegisterSuccess(ctx context.Context, email string) error {
+	return g.store.Reset(ctx, accountKey(email))
+}
+
+// Unlock снимает блокировку учётной записи и (или) IP-адреса и записывает событие в журнал
+func (g *Guard) Unlock(ctx context.Context, email, ip string, actorID uuid.UUID) error {
+	targets := []struct {
+		scope   models.LockoutScope
+		subject string
+		key     string
+	}{
+		{models.LockoutScopeAccount, normalizeEmail(email), accountKey(email)},
+		{models.LockoutScopeIP, ip, ipKey(ip)},
+	}
+
+	for _, target := range targets {
+		if target.subject == "" {
+			continue
+		}
+		if err := g.store.Reset(ctx, target.key); err != nil {
+			return err
+		}
+		err := g.auditor.Record(ctx, &models.LockoutEvent{
+			Action:  models.LockoutUnlocked,
+			Scope:   target.scope,
+			Subject: target.subject,
+			ActorID: &actorID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lock блокирует ключ на LockDuration и записывает событие в журнал.
+// Повторные неудачи во время блокировки её не продлевают и не дублируют запись в журнале.
+func (g *Guard) lock(ctx context.Context, scope models.LockoutScope, subject, key string, failures int, now time.Time) error {
+	until, err := g.store.LockedUntil(ctx, key)
+	if err != nil {
+		return err
+	}
+	if until.Sub(now) > maxDelay {
+		return nil
+	}
+
+	until = now.Add(g.cfg.LockDuration)
+	if err := g.store.Lock(ctx, key, until); err != nil {
+		return err
+	}
+
+	return g.auditor.Record(ctx, &models.LockoutEvent{
+		Action:      models.LockoutLocked,
+		Scope:       scope,
+		Subject:     subject,
+		Failures:    failures,
+		LockedUntil: &until,
+	})
+}
+
+// progressiveDelay возвращает задержку после failures неудач: 0, 0, 1s, 2s, 4s, ... не больше maxDelay
+func progressiveDelay(failures int) time.Duration {
+	if failures <= freeAttempts {
+		return 0
+	}
+	delay := baseDelay << (failures - freeAttempts - 1)
+	if delay > maxDelay || delay <= 0 {
+		return maxDelay
+	}
+	return delay
+}
+
+// normalizeEmail приводит email к единому виду для ключей и журнала
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// accountKey и ipKey формируют ключи хранилища
+func accountKey(email string) string { return "account:" + normalizeEmail(email) }
+func ipKey(ip string) string         { return "ip:" + ip }
@@ -0,0 +1,204 @@
+package lockout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"auth-service/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// recordingAuditor - журнал блокировок в памяти
+type recordingAuditor struct {
+	events []*models.LockoutEvent
+}
+
+func (a *recordingAuditor) Record(_ context.Context, event *models.LockoutEvent) error {
+	a.events = append(a.events, event)
+	return nil
+}
+
+// testConfig - пороги защиты в тестах
+var testConfig = Config{
+	MaxAccountFailures: 5,
+	MaxIPFailures:      3,
+	Window:             15 * time.Minute,
+	LockDuration:       15 * time.Minute,
+}
+
+func TestProgressiveDelay(t *testing.T) {
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{0, 0},
+		{1, 0},
+		{2, 0},
+		{3, time.Second},
+		{4, 2 * time.Second},
+		{5, 4 * time.Second},
+		{7, 16 * time.Second},
+		{8, maxDelay},
+		{100, maxDelay},
+	}
+
+	for _, tt := range tests {
+		if got := progressiveDelay(tt.failures); got != tt.want {
+			t.Errorf("progressiveDelay(%d) = %s, want %s", tt.failures, got, tt.want)
+		}
+	}
+}
+
+func TestGuardAccountThresholds(t *testing.T) {
+	tests := []struct {
+		name       string
+		failures   int
+		wantLocked bool
+		minRetry   time.Duration // Нижняя граница RetryAfter
+		maxRetry   time.Duration // Верхняя граница RetryAfter
+		wantEvents int           // События блокировки в журнале
+	}{
+		{"first typo", 1, false, 0, 0, 0},
+		{"free attempts", freeAttempts, false, 0, 0, 0},
+		{"first delay", freeAttempts + 1, true, 0, baseDelay, 0},
+		{"growing delay", freeAttempts + 2, true, baseDelay, 2 * baseDelay, 0},
+		{"account locked", testConfig.MaxAccountFailures, true, maxDelay, testConfig.LockDuration, 1},
+		{"lock is not extended", testConfig.MaxAccountFailures + 3, true, maxDelay, testConfig.LockDuration, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			auditor := &recordingAuditor{}
+			guard := NewGuard(NewMemoryStore(), auditor, testConfig)
+
+			// Каждая неудача с нового адреса, чтобы не сработала блокировка по IP
+			for i := 0; i < tt.failures; i++ {
+				if err := guard.RegisterFailure(ctx, "Student@Example.com", fmt.Sprintf("10.0.0.%d", i)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := guard.Check(ctx, "student@example.com", "192.168.1.1")
+			var locked *LockedError
+			if got := errors.As(err, &locked); got != tt.wantLocked {
+				t.Fatalf("locked = %v, want %v (err %v)", got, tt.wantLocked, err)
+			}
+			if locked != nil && (locked.RetryAfter <= tt.minRetry || locked.RetryAfter > tt.maxRetry) {
+				t.Errorf("RetryAfter = %s, want in (%s, %s]", locked.RetryAfter, tt.minRetry, tt.maxRetry)
+			}
+
+			if len(auditor.events) != tt.wantEvents {
+				t.Fatalf("events = %d, want %d", len(auditor.events), tt.wantEvents)
+			}
+			for _, event := range auditor.events {
+				if event.Action != models.LockoutLocked || event.Scope != models.LockoutScopeAccount || event.Subject != "student@example.com" {
+					t.Errorf("event = %+v, want account lock of student@example.com", event)
+				}
+			}
+		})
+	}
+}
+
+func TestGuardIPThreshold(t *testing.T) {
+	tests := []struct {
+		name       string
+		failures   int
+		wantLocked bool
+	}{
+		{"below threshold", testConfig.MaxIPFailures - 1, false},
+		{"at threshold", testConfig.MaxIPFailures, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			auditor := &recordingAuditor{}
+			guard := NewGuard(NewMemoryStore(), auditor, testConfig)
+
+			// Перебор разных учётных записей с одного адреса
+			for i := 0; i < tt.failures; i++ {
+				if err := guard.RegisterFailure(ctx, fmt.Sprintf("user%d@example.com", i), "203.0.113.7"); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := guard.Check(ctx, "other@example.com", "203.0.113.7")
+			var locked *LockedError
+			if got := errors.As(err, &locked); got != tt.wantLocked {
+				t.Fatalf("locked = %v, want %v (err %v)", got, tt.wantLocked, err)
+			}
+
+			// Другой адрес не затронут
+			if err := guard.Check(ctx, "other@example.com", "203.0.113.8"); err != nil {
+				t.Errorf("other IP: %v", err)
+			}
+
+			if tt.wantLocked && (len(auditor.events) != 1 || auditor.events[0].Scope != models.LockoutScopeIP) {
+				t.Errorf("events = %+v, want one IP lock", auditor.events)
+			}
+		})
+	}
+}
+
+func TestGuardResetAndUnlock(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success resets account failures", func(t *testing.T) {
+		guard := NewGuard(NewMemoryStore(), &recordingAuditor{}, testConfig)
+		for i := 0; i < freeAttempts; i++ {
+			if err := guard.RegisterFailure(ctx, "student@example.com", ""); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := guard.RegisterSuccess(ctx, "student@example.com"); err != nil {
+			t.Fatal(err)
+		}
+
+		// После сброса снова доступны попытки без задержки
+		if err := guard.RegisterFailure(ctx, "student@example.com", ""); err != nil {
+			t.Fatal(err)
+		}
+		if err := guard.Check(ctx, "student@example.com", ""); err != nil {
+			t.Errorf("Check after reset: %v", err)
+		}
+	})
+
+	t.Run("unlock lifts account and IP locks", func(t *testing.T) {
+		auditor := &recordingAuditor{}
+		guard := NewGuard(NewMemoryStore(), auditor, testConfig)
+		for i := 0; i < testConfig.MaxAccountFailures; i++ {
+			if err := guard.RegisterFailure(ctx, "student@example.com", "203.0.113.7"); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := guard.Check(ctx, "student@example.com", "203.0.113.7"); err == nil {
+			t.Fatal("expected lock before unlock")
+		}
+
+		actorID := uuid.New()
+		if err := guard.Unlock(ctx, "student@example.com", "203.0.113.7", actorID); err != nil {
+			t.Fatal(err)
+		}
+		if err := guard.Check(ctx, "student@example.com", "203.0.113.7"); err != nil {
+			t.Errorf("Check after unlock: %v", err)
+		}
+
+		var unlocked int
+		for _, event := range auditor.events {
+			if event.Action == models.LockoutUnlocked {
+				unlocked++
+				if event.ActorID == nil || *event.ActorID != actorID {
+					t.Errorf("unlock event actor = %v, want %s", event.ActorID, actorID)
+				}
+			}
+		}
+		if unlocked != 2 {
+			t.Errorf("unlock events = %d, want 2", unlocked)
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package lockout
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PostgresStore хранит счётчики в таблице login_attempts (общая для всех экземпляров сервиса)
+type PostgresStore struct {
+	db *gorm.DB
+}
+
+// NewPostgresStore создаёт хранилище поверх PostgreSQL
+func NewPostgresStore(db *gorm.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Increment реализует Store. Счётчик увеличивается атомарно одним upsert-запросом.
+func (s *PostgresStore) Increment(ctx context.Context, key string, window time.Duration) (int, error) {
+	now := time.Now()
+
+	var failures int
+	err := s.db.WithContext(ctx).Raw(`
+		INSERT INTO login_attempts (attempt_key, failures, last_failure_at)
+		VALUES (?, 1, ?)
+		ON CONFLICT (attempt_key) DO UPDATE SET
+			failures = CASE
+				WHEN login_attempts.last_failure_at < ? THEN 1
+				ELSE login_attempts.failures + 1
+			END,
+			last_failure_at = EXCLUDED.last_failure_at
+		RETURNING failures
+	`, key, now, now.Add(-window)).Scan(&failures).Error
+	if err != nil {
+		return 0, err
+	}
+	return failures, nil
+}
+
+// LockedUntil реализует Store
+func (s *PostgresStore) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	var attempt models.LoginAttempt
+	if err := s.db.WithContext(ctx).Where("attempt_key = ?", key).First(&attempt).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	if attempt.LockedUntil == nil {
+		return time.Time{}, nil
+	}
+	return *attempt.LockedUntil, nil
+}
+
+// Lock реализует Store
+func (s *PostgresStore) Lock(ctx context.Context, key string, until time.Time) error {
+	return s.db.WithContext(ctx).Exec(`
+		INSERT INTO login_attempts (attempt_key, failures, last_failure_at, locked_until)
+		VALUES (?, 0, ?, ?)
+		ON CONFLICT (attempt_key) DO UPDATE SET locked_until = EXCLUDED.locked_until
+	`, key, time.Now(), until).Error
+}
+
+// Reset реализует Store
+func (s *PostgresStore) Reset(ctx context.Context, key string) error {
+	return s.db.WithContext(ctx).Where("attempt_key = ?", key).Delete(&models.LoginAttempt{}).Error
+}
@@ -0,0 +1,20 @@
+package lockout
+
+import (
+	"context"
+	"time"
+)
+
+// Store хранит счётчики неудачных попыток входа и блокировки.
+// Реализации: MemoryStore (один экземпляр сервиса, тесты) и PostgresStore (общий для всех экземпляров).
+type Store interface {
+	// Increment увеличивает счётчик неудач по ключу и возвращает новое значение.
+	// Если с последней неудачи прошло больше window, счёт начинается заново.
+	Increment(ctx context.Context, key string, window time.Duration) (int, error)
+	// LockedUntil возвращает время окончания блокировки (нулевое, если блокировки нет)
+	LockedUntil(ctx context.Context, key string) (time.Time, error)
+	// Lock запрещает вход по ключу до указанного времени
+	Lock(ctx context.Context, key string, until time.Time) error
+	// Reset сбрасывает счётчик и блокировку
+	Reset(ctx context.Context, key string) error
+}
@@ -0,0 +1,74 @@
+package mail
+
+import (
+	"fmt"
+	"log/slog"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Message представляет письмо пользователю
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender определяет интерфейс отправки писем.
+// Для production подключается реализация поверх SMTP или почтового API.
+type Sender interface {
+	Send(msg Message) error
+}
+
+// NewSender создаёт отправителя по названию: "log" или "file"
+func NewSender(kind, from, dir string) (Sender, error) {
+	switch kind {
+	case "log":
+		return &logSender{from: from}, nil
+	case "file":
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("ошибка создания каталога писем: %w", err)
+		}
+		return &fileSender{from: from, dir: dir}, nil
+	default:
+		return nil, fmt.Errorf("неизвестный способ отправки писем: %s", kind)
+	}
+}
+
+// logSender выводит письма в журнал (для локальной разработки). Адреса и токены в ссылках
+// маскируются, как и в остальном журнале; чтобы переходить по ссылкам, используйте MAIL_SENDER=file
+type logSender struct {
+	from string
+}
+
+// Send реализует Sender
+func (s *logSender) Send(msg Message) error {
+	slog.Info("Письмо", "from", s.from, "to", msg.To, "subject", msg.Subject, "body", msg.Body)
+	return nil
+}
+
+// fileSender сохраняет письма в каталог в формате .eml (для локальной разработки)
+type fileSender struct {
+	from string
+	dir  string
+}
+
+// Send реализует Sender
+func (s *fileSender) Send(msg Message) error {
+	name := fmt.Sprintf("%s-%s.eml", time.Now().Format("20060102-150405"), uuid.NewString())
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", s.from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(msg.Body)
+
+	return os.WriteFile(filepath.Join(s.dir, name), []byte(b.String()), 0o644)
+}
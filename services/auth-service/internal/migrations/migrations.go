@@ -0,0 +1,254 @@
+// Package migrations применяет версионные миграции схемы БД, встроенные в бинарный файл
+package migrations
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// files - SQL файлы миграций: <версия>_<название>.up.sql и <версия>_<название>.down.sql
+//
+//go:embed sql/*.sql
+var files embed.FS
+
+// fileNamePattern - формат имени файла миграции
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration - версионное изменение схемы с SQL для применения и отката
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status - миграция и время её применения (nil - ещё не применена)
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// appliedMigration - запись о применённой миграции
+type appliedMigration struct {
+	Version   int64
+	Name      string
+	AppliedAt time.Time
+}
+
+// createTableSQL создаёт таблицу применённых миграций
+const createTableSQL = `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version bigint PRIMARY KEY,
+		name varchar(255) NOT NULL,
+		applied_at timestamptz NOT NULL DEFAULT NOW()
+	)`
+
+// lockSQL - advisory-блокировка на время транзакции, чтобы несколько экземпляров
+// сервиса не применяли миграции одновременно
+const lockSQL = "SELECT pg_advisory_xact_lock(hashtext('auth-service.schema_migrations'))"
+
+// Migrator применяет и откатывает миграции; каждая миграция и запись о ней
+// в schema_migrations выполняются в одной транзакции
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator создаёт Migrator со встроенными в бинарный файл миграциями
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	sqlFiles, err := fs.Sub(files, "sql")
+	if err != nil {
+		return nil, err
+	}
+	migrations, err := Load(sqlFiles)
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// Load читает миграции из корня fsys и упорядочивает их по версии.
+// У каждой миграции должны быть оба файла, версии не повторяются.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			return nil, fmt.Errorf("некорректное имя файла миграции %s", entry.Name())
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("некорректная версия миграции %s", entry.Name())
+		}
+		content, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("версия %d используется миграциями %s и %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("у миграции %d_%s должны быть файлы up и down", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Up применяет все ещё не применённые миграции и возвращает их
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	for _, migration := range m.migrations {
+		done := false
+		err := m.inLock(ctx, func(tx *gorm.DB) error {
+			// Миграцию мог уже применить другой экземпляр, пока этот ждал блокировку
+			var count int64
+			if err := tx.Table("schema_migrations").Where("version = ?", migration.Version).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return nil
+			}
+
+			if err := tx.Exec(migration.Up).Error; err != nil {
+				return err
+			}
+			done = true
+			return tx.Exec("INSERT INTO schema_migrations (version, name) VALUES (?, ?)", migration.Version, migration.Name).Error
+		})
+		if err != nil {
+			return applied, fmt.Errorf("ошибка применения миграции %d_%s: %w", migration.Version, migration.Name, err)
+		}
+		if done {
+			applied = append(applied, migration)
+		}
+	}
+	return applied, nil
+}
+
+// Down откатывает steps последних применённых миграций и возвращает их
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var reverted []Migration
+	for range steps {
+		var migration *Migration
+		err := m.inLock(ctx, func(tx *gorm.DB) error {
+			var last []appliedMigration
+			if err := tx.Table("schema_migrations").Order("version DESC").Limit(1).Find(&last).Error; err != nil {
+				return err
+			}
+			if len(last) == 0 {
+				return nil
+			}
+
+			migration = m.find(last[0].Version)
+			if migration == nil {
+				return fmt.Errorf("миграция %d_%s применена более новой версией сервиса", last[0].Version, last[0].Name)
+			}
+			if err := tx.Exec(migration.Down).Error; err != nil {
+				return fmt.Errorf("ошибка отката миграции %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			return tx.Exec("DELETE FROM schema_migrations WHERE version = ?", migration.Version).Error
+		})
+		if err != nil {
+			return reverted, err
+		}
+		if migration == nil {
+			break
+		}
+		reverted = append(reverted, *migration)
+	}
+	return reverted, nil
+}
+
+// Status возвращает все миграции этой версии сервиса с временем их применения
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var applied []appliedMigration
+	err := m.inLock(ctx, func(tx *gorm.DB) error {
+		return tx.Table("schema_migrations").Find(&applied).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	appliedAt := make(map[int64]time.Time, len(applied))
+	for _, migration := range applied {
+		appliedAt[migration.Version] = migration.AppliedAt
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Migration: migration}
+		if at, ok := appliedAt[migration.Version]; ok {
+			status.AppliedAt = &at
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Pending возвращает ещё не применённые миграции
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var pending []Migration
+	for _, status := range statuses {
+		if status.AppliedAt == nil {
+			pending = append(pending, status.Migration)
+		}
+	}
+	return pending, nil
+}
+
+// inLock выполняет fn в транзакции под advisory-блокировкой миграций
+func (m *Migrator) inLock(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(lockSQL).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec(createTableSQL).Error; err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
+// find возвращает миграцию по версии или nil
+func (m *Migrator) find(version int64) *Migration {
+	for i := range m.migrations {
+		if m.migrations[i].Version == version {
+			return &m.migrations[i]
+		}
+	}
+	return nil
+}
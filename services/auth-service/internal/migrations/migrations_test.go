@@ -0,0 +1,146 @@
+package migrations
+
+import (
+	"auth-service/internal/models"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"gorm.io/gorm/schema"
+)
+
+func TestLoad(t *testing.T) {
+	file := func(content string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(content)} }
+
+	tests := []struct {
+		name         string
+		files        fstest.MapFS
+		wantVersions []int64
+		wantErr      bool
+	}{
+		{
+			name: "ordered by version",
+			files: fstest.MapFS{
+				"000010_second.up.sql":   file("CREATE TABLE b ()"),
+				"000010_second.down.sql": file("DROP TABLE b"),
+				"000002_first.up.sql":    file("CREATE TABLE a ()"),
+				"000002_first.down.sql":  file("DROP TABLE a"),
+			},
+			wantVersions: []int64{2, 10},
+		},
+		{
+			name:    "missing down",
+			files:   fstest.MapFS{"000001_first.up.sql": file("CREATE TABLE a ()")},
+			wantErr: true,
+		},
+		{
+			name: "version used twice",
+			files: fstest.MapFS{
+				"000001_first.up.sql":   file("CREATE TABLE a ()"),
+				"000001_first.down.sql": file("DROP TABLE a"),
+				"000001_other.up.sql":   file("CREATE TABLE b ()"),
+				"000001_other.down.sql": file("DROP TABLE b"),
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unexpected file",
+			files:   fstest.MapFS{"first.sql": file("CREATE TABLE a ()")},
+			wantErr: true,
+		},
+		{
+			name: "zero version",
+			files: fstest.MapFS{
+				"000000_first.up.sql":   file("CREATE TABLE a ()"),
+				"000000_first.down.sql": file("DROP TABLE a"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := Load(tt.files)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Load error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var versions []int64
+			for _, migration := range migrations {
+				versions = append(versions, migration.Version)
+			}
+			if len(versions) != len(tt.wantVersions) {
+				t.Fatalf("versions = %v, want %v", versions, tt.wantVersions)
+			}
+			for i := range versions {
+				if versions[i] != tt.wantVersions[i] {
+					t.Errorf("versions = %v, want %v", versions, tt.wantVersions)
+				}
+			}
+		})
+	}
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrator, err := NewMigrator(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, migration := range migrator.migrations {
+		if migration.Version != int64(i+1) {
+			t.Errorf("migration %d_%s, want version %d: versions go without gaps", migration.Version, migration.Name, i+1)
+		}
+	}
+}
+
+// TestMigrationsCoverModels проверяет, что миграции создают таблицу и все колонки каждой модели:
+// схема больше не подстраивается под модели при запуске
+func TestMigrationsCoverModels(t *testing.T) {
+	migrator, err := NewMigrator(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var up strings.Builder
+	for _, migration := range migrator.migrations {
+		up.WriteString(migration.Up)
+	}
+
+	tables := []interface{}{
+		&models.User{},
+		&models.EmployerProfile{}, &models.UniversityProfile{},
+		&models.Session{}, &models.RefreshToken{},
+		&models.UserToken{},
+		&models.LoginAttempt{}, &models.LockoutEvent{},
+		&models.UserMFA{}, &models.MFARecoveryCode{}, &models.MFARolePolicy{},
+		&models.ExternalIdentity{}, &models.OIDCState{},
+		&models.AdminAuditEvent{},
+		&models.Invitation{},
+	}
+	for _, table := range tables {
+		parsed, err := schema.Parse(table, &sync.Map{}, schema.NamingStrategy{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Колонки таблицы: из CREATE TABLE и из последующих ALTER TABLE ... ADD COLUMN
+		create := regexp.MustCompile(`(?s)CREATE TABLE IF NOT EXISTS ` + parsed.Table + ` \((.*?)\n\);`).FindStringSubmatch(up.String())
+		if create == nil {
+			t.Errorf("no migration creates table %s", parsed.Table)
+			continue
+		}
+		columns := create[1]
+		for _, added := range regexp.MustCompile(`ALTER TABLE `+parsed.Table+` ADD COLUMN IF NOT EXISTS (\w+)`).FindAllStringSubmatch(up.String(), -1) {
+			columns += "\n" + added[1]
+		}
+
+		for _, field := range parsed.Fields {
+			if field.DBName == "" {
+				continue
+			}
+			if !regexp.MustCompile(`(?m)^\s*` + field.DBName + `\b`).MatchString(columns) {
+				t.Errorf("no migration creates column %s.%s", parsed.Table, field.DBName)
+			}
+		}
+	}
+}
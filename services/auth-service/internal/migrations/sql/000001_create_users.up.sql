@@ -0,0 +1,19 @@
+-- Роли пользователей и учётные записи.
+-- IF NOT EXISTS: база, созданная до версионных миграций (AutoMigrate), принимается как есть.
+DO $$ BEGIN
+    CREATE TYPE user_role AS ENUM ('student', 'employer', 'university', 'admin');
+EXCEPTION
+    WHEN duplicate_object THEN null;
+END $$;
+
+CREATE TABLE IF NOT EXISTS users (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    email varchar(255) NOT NULL,
+    password_hash varchar(255) NOT NULL,
+    role user_role NOT NULL DEFAULT 'student',
+    is_active boolean DEFAULT true,
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
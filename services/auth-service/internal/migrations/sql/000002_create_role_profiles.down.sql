@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS university_profiles;
+DROP TABLE IF EXISTS employer_profiles;
@@ -0,0 +1,21 @@
+-- Профили работодателей и университетов (профиль студента хранит student-service)
+CREATE TABLE IF NOT EXISTS employer_profiles (
+    user_id uuid PRIMARY KEY,
+    bin varchar(12) NOT NULL,
+    company_name varchar(255) NOT NULL,
+    company_email varchar(255) NOT NULL,
+    contact_phone varchar(20) NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_employer_profiles_bin ON employer_profiles (bin);
+
+CREATE TABLE IF NOT EXISTS university_profiles (
+    user_id uuid PRIMARY KEY,
+    university_name varchar(255) NOT NULL,
+    university_email varchar(255) NOT NULL,
+    contact_phone varchar(20) NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz
+);
@@ -0,0 +1,50 @@
+-- Сеансы входа и refresh токены, выданные в рамках сеанса
+CREATE TABLE IF NOT EXISTS sessions (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id uuid NOT NULL,
+    device varchar(255),
+    ip_address varchar(45),
+    user_agent varchar(512),
+    expires_at timestamptz NOT NULL,
+    last_used_at timestamptz NOT NULL,
+    revoked_at timestamptz,
+    created_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions (user_id);
+CREATE INDEX IF NOT EXISTS idx_sessions_revoked_at ON sessions (revoked_at);
+
+-- Семейства refresh токенов стали сеансами: в базе, созданной AutoMigrate до появления сеансов,
+-- колонка и её индекс ещё называются по family_id
+DO $$ BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns
+               WHERE table_schema = current_schema() AND table_name = 'refresh_tokens' AND column_name = 'family_id')
+       AND NOT EXISTS (SELECT 1 FROM information_schema.columns
+                       WHERE table_schema = current_schema() AND table_name = 'refresh_tokens' AND column_name = 'session_id') THEN
+        ALTER TABLE refresh_tokens RENAME COLUMN family_id TO session_id;
+    END IF;
+
+    IF to_regclass('idx_refresh_tokens_session_id') IS NULL THEN
+        ALTER INDEX IF EXISTS idx_refresh_tokens_family_id RENAME TO idx_refresh_tokens_session_id;
+    ELSE
+        DROP INDEX IF EXISTS idx_refresh_tokens_family_id;
+    END IF;
+END $$;
+
+-- Журнал разовых изменений схемы, выполнявшихся при запуске, заменён schema_migrations
+DROP TABLE IF EXISTS schema_steps;
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id uuid PRIMARY KEY,
+    user_id uuid NOT NULL,
+    session_id uuid NOT NULL,
+    token_hash varchar(64) NOT NULL,
+    expires_at timestamptz NOT NULL,
+    rotated_at timestamptz,
+    revoked_at timestamptz,
+    created_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_session_id ON refresh_tokens (session_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_refresh_tokens_token_hash ON refresh_tokens (token_hash);
@@ -0,0 +1,20 @@
+-- Подтверждение email и одноразовые токены (подтверждение email, сброс пароля, вход через провайдера).
+-- Пользователи, зарегистрированные до появления подтверждения, считаются подтвердившими email.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified boolean NOT NULL DEFAULT true;
+ALTER TABLE users ALTER COLUMN email_verified SET DEFAULT false;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified_at timestamptz;
+UPDATE users SET email_verified_at = created_at WHERE email_verified AND email_verified_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS user_tokens (
+    id uuid PRIMARY KEY,
+    user_id uuid NOT NULL,
+    purpose varchar(32) NOT NULL,
+    token_hash varchar(64) NOT NULL,
+    expires_at timestamptz NOT NULL,
+    used_at timestamptz,
+    created_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_tokens_user_id ON user_tokens (user_id);
+CREATE INDEX IF NOT EXISTS idx_user_tokens_purpose ON user_tokens (purpose);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_tokens_token_hash ON user_tokens (token_hash);
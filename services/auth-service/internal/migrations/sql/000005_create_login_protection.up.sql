@@ -0,0 +1,21 @@
+-- Счётчики неудачных попыток входа и журнал блокировок
+CREATE TABLE IF NOT EXISTS login_attempts (
+    attempt_key varchar(320) PRIMARY KEY,
+    failures bigint NOT NULL DEFAULT 0,
+    last_failure_at timestamptz NOT NULL,
+    locked_until timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS lockout_events (
+    id uuid PRIMARY KEY,
+    action varchar(16) NOT NULL,
+    scope varchar(16) NOT NULL,
+    subject varchar(320) NOT NULL,
+    failures bigint,
+    locked_until timestamptz,
+    actor_id uuid,
+    created_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_lockout_events_subject ON lockout_events (subject);
+CREATE INDEX IF NOT EXISTS idx_lockout_events_created_at ON lockout_events (created_at);
@@ -0,0 +1,26 @@
+-- Второй фактор: TOTP, коды восстановления и требования по ролям
+CREATE TABLE IF NOT EXISTS user_mfa (
+    user_id uuid PRIMARY KEY,
+    secret varchar(64) NOT NULL,
+    confirmed_at timestamptz,
+    last_used_step bigint NOT NULL DEFAULT 0,
+    created_at timestamptz,
+    updated_at timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+    id uuid PRIMARY KEY,
+    user_id uuid NOT NULL,
+    code_hash varchar(64) NOT NULL,
+    used_at timestamptz,
+    created_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_mfa_recovery_codes_user_id ON mfa_recovery_codes (user_id);
+
+CREATE TABLE IF NOT EXISTS mfa_role_policies (
+    role user_role PRIMARY KEY,
+    required boolean NOT NULL DEFAULT false,
+    updated_by uuid,
+    updated_at timestamptz
+);
@@ -0,0 +1,24 @@
+-- Вход через провайдеров OIDC: связанные учётные записи и параметры начатых входов
+CREATE TABLE IF NOT EXISTS external_identities (
+    id uuid PRIMARY KEY,
+    user_id uuid NOT NULL,
+    provider varchar(64) NOT NULL,
+    subject varchar(255) NOT NULL,
+    email varchar(255),
+    created_at timestamptz,
+    last_login_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_external_identities_user_id ON external_identities (user_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_external_identity_subject ON external_identities (provider, subject);
+
+CREATE TABLE IF NOT EXISTS oidc_states (
+    state_hash varchar(64) PRIMARY KEY,
+    provider varchar(64) NOT NULL,
+    nonce varchar(64) NOT NULL,
+    code_verifier varchar(128) NOT NULL,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_oidc_states_expires_at ON oidc_states (expires_at);
@@ -0,0 +1,17 @@
+-- Журнал действий администраторов и мягкое удаление пользователей
+CREATE TABLE IF NOT EXISTS admin_audit_events (
+    id uuid PRIMARY KEY,
+    actor_id uuid NOT NULL,
+    target_user_id uuid NOT NULL,
+    action varchar(32) NOT NULL,
+    details jsonb,
+    reason varchar(500),
+    created_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_audit_events_actor_id ON admin_audit_events (actor_id);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_events_target_user_id ON admin_audit_events (target_user_id);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_events_created_at ON admin_audit_events (created_at);
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
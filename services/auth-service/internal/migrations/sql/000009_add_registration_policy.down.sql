@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS invitations;
+DROP INDEX IF EXISTS idx_users_approval_status;
+ALTER TABLE users DROP COLUMN IF EXISTS approval_status;
@@ -0,0 +1,17 @@
+-- Приглашения и одобрение регистрации университетов администратором
+ALTER TABLE users ADD COLUMN IF NOT EXISTS approval_status varchar(16) NOT NULL DEFAULT 'approved';
+CREATE INDEX IF NOT EXISTS idx_users_approval_status ON users (approval_status);
+
+CREATE TABLE IF NOT EXISTS invitations (
+    id uuid PRIMARY KEY,
+    email varchar(255) NOT NULL,
+    role user_role NOT NULL,
+    token_hash varchar(64) NOT NULL,
+    created_by uuid NOT NULL,
+    expires_at timestamptz NOT NULL,
+    used_at timestamptz,
+    created_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS idx_invitations_email ON invitations (email);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_invitations_token_hash ON invitations (token_hash);
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AdminAction определяет тип действия администратора над учётной записью
+type AdminAction string
+
+const (
+	AdminActionActivate      AdminAction = "user_activated"        // Учётная запись активирована
+	AdminActionDeactivate    AdminAction = "user_deactivated"      // Учётная запись деактивирована
+	AdminActionChangeRole    AdminAction = "role_changed"          // Изменена роль
+	AdminActionPasswordReset AdminAction = "password_reset_forced" // Принудительный сброс пароля
+	AdminActionDelete        AdminAction = "user_deleted"          // Учётная запись удалена (мягкое удаление)
+	AdminActionApprove       AdminAction = "user_approved"         // Регистрация одобрена
+	AdminActionReject        AdminAction = "user_rejected"         // Регистрация отклонена
+)
+
+// AdminAuditEvent - запись журнала действий администраторов над учётными записями
+type AdminAuditEvent struct {
+	ID           uuid.UUID         `gorm:"type:uuid;primary_key"`
+	ActorID      uuid.UUID         `gorm:"type:uuid;not null;index"`
+	TargetUserID uuid.UUID         `gorm:"type:uuid;not null;index"`
+	Action       AdminAction       `gorm:"type:varchar(32);not null"`
+	Details      map[string]string `gorm:"type:jsonb;serializer:json"` // Например, прежняя и новая роль
+	Reason       string            `gorm:"type:varchar(500)"`
+	CreatedAt    time.Time         `gorm:"autoCreateTime;index"`
+}
+
+// TableName возвращает имя таблицы для модели AdminAuditEvent
+func (AdminAuditEvent) TableName() string {
+	return "admin_audit_events"
+}
+
+// BeforeCreate выполняется перед созданием записи
+func (e *AdminAuditEvent) BeforeCreate(tx *gorm.DB) error {
+	// Генерация UUID если не задан
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ExternalIdentity связывает пользователя с учётной записью у внешнего провайдера (SSO университета)
+type ExternalIdentity struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key"`
+	UserID      uuid.UUID `gorm:"type:uuid;index;not null"`
+	Provider    string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_external_identity_subject"`
+	Subject     string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_external_identity_subject"` // Claim sub провайдера
+	Email       string    `gorm:"type:varchar(255)"`                                                    // Email на момент связывания
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+	LastLoginAt time.Time
+}
+
+// TableName возвращает имя таблицы для модели ExternalIdentity
+func (ExternalIdentity) TableName() string {
+	return "external_identities"
+}
+
+// BeforeCreate выполняется перед созданием записи
+func (i *ExternalIdentity) BeforeCreate(tx *gorm.DB) error {
+	if i.ID == uuid.Nil {
+		i.ID = uuid.New()
+	}
+	return nil
+}
+
+// OIDCState хранит параметры начатого входа через провайдера до возврата пользователя.
+// Сам state не сохраняется — только его SHA-256 хеш.
+type OIDCState struct {
+	StateHash    string    `gorm:"type:varchar(64);primary_key"`
+	Provider     string    `gorm:"type:varchar(64);not null"`
+	Nonce        string    `gorm:"type:varchar(64);not null"`
+	CodeVerifier string    `gorm:"type:varchar(128);not null"` // PKCE
+	ExpiresAt    time.Time `gorm:"index;not null"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName возвращает имя таблицы для модели OIDCState
+func (OIDCState) TableName() string {
+	return "oidc_states"
+}
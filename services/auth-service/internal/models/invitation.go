@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Invitation - приглашение администратора зарегистрироваться с указанной ролью.
+// Так создаются учётные записи университетов и администраторов без ожидания одобрения.
+// Сам токен приглашения не сохраняется — только его SHA-256 хеш.
+type Invitation struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key"`
+	Email     string     `gorm:"type:varchar(255);index;not null"`
+	Role      UserRole   `gorm:"type:user_role;not null"`
+	TokenHash string     `gorm:"type:varchar(64);uniqueIndex;not null"`
+	CreatedBy uuid.UUID  `gorm:"type:uuid;not null"`
+	ExpiresAt time.Time  `gorm:"not null"`
+	UsedAt    *time.Time // Приглашение использовано при регистрации
+	CreatedAt time.Time  `gorm:"autoCreateTime"`
+}
+
+// TableName возвращает имя таблицы для модели Invitation
+func (Invitation) TableName() string {
+	return "invitations"
+}
+
+// IsUsable проверяет, что приглашение не использовано и не истекло
+func (i *Invitation) IsUsable() bool {
+	return i.UsedAt == nil && time.Now().Before(i.ExpiresAt)
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// LoginAttempt хранит счётчик неудачных попыток входа по ключу (учётная запись или IP-адрес)
+type LoginAttempt struct {
+	AttemptKey    string     `gorm:"type:varchar(320);primary_key"`
+	Failures      int        `gorm:"not null;default:0"`
+	LastFailureAt time.Time  `gorm:"not null"`
+	LockedUntil   *time.Time // Вход запрещён до указанного времени
+}
+
+// TableName возвращает имя таблицы для модели LoginAttempt
+func (LoginAttempt) TableName() string {
+	return "login_attempts"
+}
+
+// LockoutAction определяет тип события журнала блокировок
+type LockoutAction string
+
+const (
+	LockoutLocked   LockoutAction = "locked"   // Вход заблокирован после серии неудачных попыток
+	LockoutUnlocked LockoutAction = "unlocked" // Блокировка снята администратором
+)
+
+// LockoutScope определяет, что именно заблокировано
+type LockoutScope string
+
+const (
+	LockoutScopeAccount LockoutScope = "account" // Учётная запись (по email)
+	LockoutScopeIP      LockoutScope = "ip"      // IP-адрес клиента
+)
+
+// LockoutEvent - запись журнала блокировок входа
+type LockoutEvent struct {
+	ID          uuid.UUID     `gorm:"type:uuid;primary_key"`
+	Action      LockoutAction `gorm:"type:varchar(16);not null"`
+	Scope       LockoutScope  `gorm:"type:varchar(16);not null"`
+	Subject     string        `gorm:"type:varchar(320);index;not null"` // Email или IP-адрес
+	Failures    int           // Число неудачных попыток на момент блокировки
+	LockedUntil *time.Time
+	ActorID     *uuid.UUID `gorm:"type:uuid"` // Администратор, снявший блокировку
+	CreatedAt   time.Time  `gorm:"autoCreateTime;index"`
+}
+
+// TableName возвращает имя таблицы для модели LockoutEvent
+func (LockoutEvent) TableName() string {
+	return "lockout_events"
+}
+
+// BeforeCreate выполняется перед созданием записи
+func (e *LockoutEvent) BeforeCreate(tx *gorm.DB) error {
+	// Генерация UUID если не задан
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return nil
+}
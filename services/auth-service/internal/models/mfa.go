@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// UserMFA хранит TOTP-секрет пользователя.
+// Второй фактор включён только после подтверждения первым кодом из приложения.
+type UserMFA struct {
+	UserID       uuid.UUID  `gorm:"type:uuid;primary_key"`
+	Secret       string     `gorm:"type:varchar(64);not null"` // base32
+	ConfirmedAt  *time.Time // nil - подключение начато, но не подтверждено
+	LastUsedStep int64      `gorm:"not null;default:0"` // Шаг последнего принятого кода (защита от повтора)
+	CreatedAt    time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time  `gorm:"autoUpdateTime"`
+}
+
+// TableName возвращает имя таблицы для модели UserMFA
+func (UserMFA) TableName() string {
+	return "user_mfa"
+}
+
+// IsEnabled проверяет, что второй фактор подтверждён и действует при входе
+func (m *UserMFA) IsEnabled() bool {
+	return m.ConfirmedAt != nil
+}
+
+// MFARecoveryCode хранит одноразовый код восстановления (только SHA-256 хеш)
+type MFARecoveryCode struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key"`
+	UserID    uuid.UUID  `gorm:"type:uuid;index;not null"`
+	CodeHash  string     `gorm:"type:varchar(64);not null"`
+	UsedAt    *time.Time // Код использован при входе
+	CreatedAt time.Time  `gorm:"autoCreateTime"`
+}
+
+// TableName возвращает имя таблицы для модели MFARecoveryCode
+func (MFARecoveryCode) TableName() string {
+	return "mfa_recovery_codes"
+}
+
+// BeforeCreate выполняется перед созданием записи
+func (c *MFARecoveryCode) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// MFARolePolicy определяет, обязателен ли второй фактор для роли
+type MFARolePolicy struct {
+	Role      UserRole   `gorm:"type:user_role;primary_key"`
+	Required  bool       `gorm:"not null;default:false"`
+	UpdatedBy *uuid.UUID `gorm:"type:uuid"` // Администратор, изменивший требование
+	UpdatedAt time.Time  `gorm:"autoUpdateTime"`
+}
+
+// TableName возвращает имя таблицы для модели MFARolePolicy
+func (MFARolePolicy) TableName() string {
+	return "mfa_role_policies"
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmployerProfile содержит данные компании работодателя
+type EmployerProfile struct {
+	UserID       uuid.UUID `gorm:"type:uuid;primary_key" json:"-"`
+	BIN          string    `gorm:"type:varchar(12);index;not null" json:"bin"`
+	CompanyName  string    `gorm:"type:varchar(255);not null" json:"companyName"`
+	CompanyEmail string    `gorm:"type:varchar(255);not null" json:"companyEmail"`
+	ContactPhone string    `gorm:"type:varchar(20);not null" json:"contactPhone"`
+	CreatedAt    time.Time `gorm:"autoCreateTime" json:"-"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime" json:"-"`
+}
+
+// TableName возвращает имя таблицы для модели EmployerProfile
+func (EmployerProfile) TableName() string {
+	return "employer_profiles"
+}
+
+// UniversityProfile содержит данные университета
+type UniversityProfile struct {
+	UserID          uuid.UUID `gorm:"type:uuid;primary_key" json:"-"`
+	UniversityName  string    `gorm:"type:varchar(255);not null" json:"universityName"`
+	UniversityEmail string    `gorm:"type:varchar(255);not null" json:"universityEmail"`
+	ContactPhone    string    `gorm:"type:varchar(20);not null" json:"contactPhone"`
+	CreatedAt       time.Time `gorm:"autoCreateTime" json:"-"`
+	UpdatedAt       time.Time `gorm:"autoUpdateTime" json:"-"`
+}
+
+// TableName возвращает имя таблицы для модели UniversityProfile
+func (UniversityProfile) TableName() string {
+	return "university_profiles"
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken хранит выданный refresh токен.
+// Сам токен не сохраняется — только его SHA-256 хеш.
+// Все токены, полученные последовательной ротацией, принадлежат одному сеансу (SessionID).
+type RefreshToken struct {
+	ID        uuid.UUID  `gorm:"type:uuid;primary_key"` // Совпадает с claim jti
+	UserID    uuid.UUID  `gorm:"type:uuid;index;not null"`
+	SessionID uuid.UUID  `gorm:"type:uuid;index;not null"`
+	TokenHash string     `gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt time.Time  `gorm:"not null"`
+	RotatedAt *time.Time // Токен обменян на новый; повторное использование - признак кражи
+	RevokedAt *time.Time // Токен отозван вместе с сеансом
+	CreatedAt time.Time  `gorm:"autoCreateTime"`
+}
+
+// TableName возвращает имя таблицы для модели RefreshToken
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Session представляет сеанс входа пользователя на отдельном устройстве.
+// Все refresh токены, полученные ротацией в рамках сеанса, ссылаются на него.
+type Session struct {
+	ID         uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID     uuid.UUID  `gorm:"type:uuid;index;not null"`
+	Device     string     `gorm:"type:varchar(255)"`
+	IPAddress  string     `gorm:"type:varchar(45)"`
+	UserAgent  string     `gorm:"type:varchar(512)"`
+	ExpiresAt  time.Time  `gorm:"not null"` // Продлевается при каждой ротации refresh токена
+	LastUsedAt time.Time  `gorm:"not null"`
+	RevokedAt  *time.Time `gorm:"index"`
+	CreatedAt  time.Time  `gorm:"autoCreateTime"`
+}
+
+// TableName возвращает имя таблицы для модели Session
+func (Session) TableName() string {
+	return "sessions"
+}
+
+// BeforeCreate выполняется перед созданием записи
+func (s *Session) BeforeCreate(tx *gorm.DB) error {
+	// Генерация UUID если не задан
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsActive проверяет, что сеанс не отозван и не истёк
+func (s *Session) IsActive() bool {
+	return s.RevokedAt == nil && time.Now().Before(s.ExpiresAt)
+}
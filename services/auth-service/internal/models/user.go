@@ -26,15 +26,36 @@ func (r UserRole) IsValid() bool {
 	return false
 }
 
+// IsSelfRegistrable проверяет, можно ли зарегистрироваться с ролью самостоятельно
+// (университеты - только после одобрения администратором)
+func (r UserRole) IsSelfRegistrable() bool {
+	return r == RoleStudent || r == RoleEmployer || r == RoleUniversity
+}
+
+// ApprovalStatus определяет состояние проверки учётной записи администратором
+type ApprovalStatus string
+
+const (
+	ApprovalApproved ApprovalStatus = "approved" // Вход разрешён
+	ApprovalPending  ApprovalStatus = "pending"  // Ожидает одобрения администратором
+	ApprovalRejected ApprovalStatus = "rejected" // Администратор отклонил регистрацию
+)
+
 // User представляет модель пользователя в системе
 type User struct {
-	ID           uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
-	Email        string    `gorm:"type:varchar(255);uniqueIndex;not null" json:"email"`
-	PasswordHash string    `gorm:"type:varchar(255);not null" json:"-"` // json:"-" скрывает поле при сериализации
-	Role         UserRole  `gorm:"type:user_role;not null;default:'student'" json:"role"`
-	IsActive     bool      `gorm:"default:true" json:"is_active"`
-	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
-	UpdatedAt    time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+	ID              uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Email           string         `gorm:"type:varchar(255);uniqueIndex;not null" json:"email"`
+	PasswordHash    string         `gorm:"type:varchar(255);not null" json:"-"` // json:"-" скрывает поле при сериализации
+	Role            UserRole       `gorm:"type:user_role;not null;default:'student'" json:"role"`
+	IsActive        bool           `gorm:"default:true" json:"is_active"`
+	EmailVerified   bool           `gorm:"not null;default:false" json:"email_verified"` // До подтверждения доступны только операции чтения
+	EmailVerifiedAt *time.Time     `json:"email_verified_at,omitempty"`
+	ApprovalStatus  ApprovalStatus `gorm:"type:varchar(16);not null;default:'approved';index" json:"approval_status"` // Университеты без приглашения ждут одобрения
+	CreatedAt       time.Time      `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time      `gorm:"autoUpdateTime" json:"updated_at"`
+
+	// Мягкое удаление: запись скрыта из всех запросов, но email остаётся занятым
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
 }
 
 // TableName возвращает имя таблицы для модели User
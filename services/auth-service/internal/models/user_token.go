@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserTokenPurpose определяет назначение одноразового токена
+type UserTokenPurpose string
+
+const (
+	PurposeEmailVerification UserTokenPurpose = "email_verification" // Подтверждение email
+	PurposePasswordReset     UserTokenPurpose = "password_reset"     // Сброс пароля
+	PurposeOIDCLogin         UserTokenPurpose = "oidc_login"         // Одноразовый код завершения входа через провайдера
+)
+
+// UserToken хранит одноразовый токен, отправленный пользователю по почте
+// (или переданный веб-приложению после входа через провайдера).
+// Сам токен не сохраняется — только его SHA-256 хеш.
+type UserToken struct {
+	ID        uuid.UUID        `gorm:"type:uuid;primary_key"` // Для подписанных токенов совпадает с claim jti
+	UserID    uuid.UUID        `gorm:"type:uuid;index;not null"`
+	Purpose   UserTokenPurpose `gorm:"type:varchar(32);index;not null"`
+	TokenHash string           `gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt time.Time        `gorm:"not null"`
+	UsedAt    *time.Time       // Токен использован или заменён новым
+	CreatedAt time.Time        `gorm:"autoCreateTime"`
+}
+
+// TableName возвращает имя таблицы для модели UserToken
+func (UserToken) TableName() string {
+	return "user_tokens"
+}
+
+// IsUsable проверяет, что токен не использован и не истёк
+func (t *UserToken) IsUsable() bool {
+	return t.UsedAt == nil && time.Now().Before(t.ExpiresAt)
+}
@@ -0,0 +1,171 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// supportedAlgorithms - алгоритмы подписи ID токенов (HS256 с client_secret не поддерживается)
+var supportedAlgorithms = []string{"RS256", "ES256", "EdDSA"}
+
+// Параметры кэша ключей провайдера
+const (
+	keysTTL            = time.Hour
+	minRefreshInterval = 10 * time.Second // Защита от перезагрузки ключей на каждый неизвестный kid
+)
+
+// errUnknownKey - в JWKS провайдера нет ключа с указанным kid и алгоритмом
+var errUnknownKey = errors.New("неизвестный ключ подписи провайдера")
+
+// jwk - открытый ключ в формате JSON Web Key
+type jwk struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	Y         string `json:"y"`
+	N         string `json:"n"`
+	E         string `json:"e"`
+}
+
+// publicKey - разобранный ключ и алгоритм, которым он подписывает
+type publicKey struct {
+	algorithm string
+	key       crypto.PublicKey
+}
+
+// keySet - кэш JWKS провайдера; при ротации (неизвестный kid) ключи загружаются заново
+type keySet struct {
+	httpClient *http.Client
+	url        string
+
+	mu          sync.Mutex
+	keys        map[string]publicKey
+	fetchedAt   time.Time
+	lastAttempt time.Time
+}
+
+// newKeySet создаёт кэш ключей, загружаемых по адресу url
+func newKeySet(httpClient *http.Client, url string) *keySet {
+	return &keySet{httpClient: httpClient, url: url}
+}
+
+// key возвращает ключ проверки подписи для kid и алгоритма из заголовка токена
+func (s *keySet) key(ctx context.Context, kid, algorithm string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.find(kid, algorithm)
+	if !ok || time.Since(s.fetchedAt) > keysTTL {
+		if time.Since(s.lastAttempt) >= minRefreshInterval {
+			s.lastAttempt = time.Now()
+			if err := s.fetch(ctx); err != nil && !ok {
+				return nil, err
+			}
+			key, ok = s.find(kid, algorithm)
+		}
+	}
+	if !ok {
+		return nil, errUnknownKey
+	}
+	return key.key, nil
+}
+
+// find ищет ключ по kid (если провайдер не указал kid, подходит единственный ключ нужного алгоритма)
+func (s *keySet) find(kid, algorithm string) (publicKey, bool) {
+	if kid != "" {
+		key, ok := s.keys[kid]
+		return key, ok && key.algorithm == algorithm
+	}
+
+	var found publicKey
+	count := 0
+	for _, key := range s.keys {
+		if key.algorithm == algorithm {
+			found = key
+			count++
+		}
+	}
+	return found, count == 1
+}
+
+// fetch загружает JWKS провайдера (вызывается под блокировкой)
+func (s *keySet) fetch(ctx context.Context) error {
+	var body struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := getJSON(ctx, s.httpClient, s.url, &body); err != nil {
+		return err
+	}
+
+	keys := make(map[string]publicKey, len(body.Keys))
+	for i, k := range body.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.parse()
+		if err != nil {
+			continue
+		}
+		id := k.KeyID
+		if id == "" {
+			id = fmt.Sprintf("#%d", i)
+		}
+		keys[id] = key
+	}
+
+	s.keys = keys
+	s.fetchedAt = time.Now()
+	return nil
+}
+
+// parse преобразует JWK в открытый ключ
+func (k jwk) parse() (publicKey, error) {
+	switch {
+	case k.KeyType == "RSA":
+		n, errN := base64.RawURLEncoding.DecodeString(k.N)
+		e, errE := base64.RawURLEncoding.DecodeString(k.E)
+		if errN != nil || errE != nil || len(e) == 0 || len(e) > 4 {
+			return publicKey{}, fmt.Errorf("некорректный RSA ключ %q", k.KeyID)
+		}
+		return publicKey{algorithm: "RS256", key: &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}}, nil
+
+	case k.KeyType == "EC" && k.Curve == "P-256":
+		x, errX := base64.RawURLEncoding.DecodeString(k.X)
+		y, errY := base64.RawURLEncoding.DecodeString(k.Y)
+		if errX != nil || errY != nil {
+			return publicKey{}, fmt.Errorf("некорректный EC ключ %q", k.KeyID)
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			return publicKey{}, fmt.Errorf("некорректный EC ключ %q", k.KeyID)
+		}
+		return publicKey{algorithm: "ES256", key: key}, nil
+
+	case k.KeyType == "OKP" && k.Curve == "Ed25519":
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return publicKey{}, fmt.Errorf("некорректный Ed25519 ключ %q", k.KeyID)
+		}
+		return publicKey{algorithm: "EdDSA", key: ed25519.PublicKey(x)}, nil
+
+	default:
+		return publicKey{}, fmt.Errorf("неподдерживаемый тип ключа %q", k.KeyType)
+	}
+}
@@ -0,0 +1,322 @@
+// Package oidctest реализует учебного провайдера OpenID Connect (authorization code + PKCE)
+// для тестов входа через SSO и команды mock-idp. Пароли не проверяются: пользователь
+// вводит любой email и сразу возвращается в приложение. Не использовать в production.
+package oidctest
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"auth-service/internal/oidc"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Время жизни кода авторизации и ID токена
+const (
+	codeTTL    = time.Minute
+	idTokenTTL = 5 * time.Minute
+	keyID      = "mock-idp"
+)
+
+// authorization - выданный, но ещё не обменянный код авторизации
+type authorization struct {
+	email         string
+	nonce         string
+	redirectURI   string
+	codeChallenge string
+	expiresAt     time.Time
+}
+
+// Provider - состояние учебного провайдера
+type Provider struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	privateKey   ed25519.PrivateKey
+
+	mu     sync.Mutex
+	codes  map[string]authorization
+	claims func(jwt.MapClaims)
+}
+
+var loginPage = template.Must(template.New("login").Parse(`<!doctype html>
+<html><head><meta charset="utf-8"><title>Mock IdP</title></head>
+<body style="font-family: sans-serif; max-width: 360px; margin: 80px auto">
+<h2>Mock IdP</h2>
+<form method="post">
+{{range $name, $value := .Query}}<input type="hidden" name="{{$name}}" value="{{index $value 0}}">
+{{end}}<p><input type="email" name="email" placeholder="student@university.kz" required style="width: 100%"></p>
+<p><button type="submit">Войти</button></p>
+</form>
+</body></html>`))
+
+// NewProvider создаёт провайдера с адресом issuer и зарегистрированным клиентом
+func NewProvider(issuer, clientID, clientSecret string) (*Provider, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Provider{
+		issuer:       strings.TrimRight(issuer, "/"),
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		privateKey:   privateKey,
+		codes:        make(map[string]authorization),
+	}, nil
+}
+
+// Issuer возвращает адрес провайдера
+func (p *Provider) Issuer() string {
+	return p.issuer
+}
+
+// SetClaims задаёт изменение claims каждого следующего ID токена перед подписью
+// (например, неподтверждённый email или чужой nonce); nil - токены без изменений
+func (p *Provider) SetClaims(modify func(jwt.MapClaims)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.claims = modify
+}
+
+// Handler возвращает обработчик discovery, /authorize, /token и /jwks
+func (p *Provider) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("/authorize", p.authorize)
+	mux.HandleFunc("/token", p.token)
+	mux.HandleFunc("/jwks", p.jwks)
+	return mux
+}
+
+// Server - провайдер, запущенный на локальном адресе (для тестов)
+type Server struct {
+	*Provider
+	server *httptest.Server
+}
+
+// NewServer запускает провайдера на локальном адресе; issuer - адрес сервера
+func NewServer(clientID, clientSecret string) (*Server, error) {
+	server := httptest.NewUnstartedServer(nil)
+	server.Start()
+
+	provider, err := NewProvider(server.URL, clientID, clientSecret)
+	if err != nil {
+		server.Close()
+		return nil, err
+	}
+	server.Config.Handler = provider.Handler()
+
+	return &Server{Provider: provider, server: server}, nil
+}
+
+// Close останавливает сервер
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Login проходит страницу входа провайдера по адресу authURL (от oidc.Provider.AuthCodeURL)
+// от имени email и возвращает параметры, с которыми провайдер вернул пользователя
+func (s *Server) Login(authURL, email string) (url.Values, error) {
+	target, err := url.Parse(authURL)
+	if err != nil {
+		return nil, err
+	}
+	query := target.Query()
+	query.Set("login_hint", email)
+	target.RawQuery = query.Encode()
+
+	client := s.server.Client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	resp, err := client.Get(target.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusFound {
+		return nil, fmt.Errorf("провайдер ответил статусом %d", resp.StatusCode)
+	}
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		return nil, err
+	}
+	if location.Query().Get("code") == "" {
+		return nil, errors.New("провайдер не выдал код авторизации")
+	}
+	return location.Query(), nil
+}
+
+// discovery отдаёт документ OpenID Provider Metadata
+func (p *Provider) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                p.issuer,
+		"authorization_endpoint":                p.issuer + "/authorize",
+		"token_endpoint":                        p.issuer + "/token",
+		"jwks_uri":                              p.issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"EdDSA"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+// authorize показывает форму входа, а после ввода email (или сразу, если передан
+// параметр login_hint) возвращает пользователя с кодом авторизации
+func (p *Provider) authorize(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "некорректный запрос", http.StatusBadRequest)
+		return
+	}
+
+	redirectURI := r.Form.Get("redirect_uri")
+	if r.Form.Get("client_id") != p.clientID || redirectURI == "" {
+		http.Error(w, "неизвестный client_id или не указан redirect_uri", http.StatusBadRequest)
+		return
+	}
+	if r.Form.Get("response_type") != "code" || r.Form.Get("code_challenge_method") != "S256" {
+		http.Error(w, "поддерживается только response_type=code с PKCE S256", http.StatusBadRequest)
+		return
+	}
+
+	email := r.Form.Get("email")
+	if email == "" {
+		email = r.Form.Get("login_hint")
+	}
+	if email == "" {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = loginPage.Execute(w, map[string]interface{}{"Query": r.URL.Query()})
+		return
+	}
+
+	code := randomString()
+	p.mu.Lock()
+	p.codes[code] = authorization{
+		email:         strings.ToLower(email),
+		nonce:         r.Form.Get("nonce"),
+		redirectURI:   redirectURI,
+		codeChallenge: r.Form.Get("code_challenge"),
+		expiresAt:     time.Now().Add(codeTTL),
+	}
+	p.mu.Unlock()
+
+	query := url.Values{"code": {code}, "state": {r.Form.Get("state")}}
+	http.Redirect(w, r, redirectURI+"?"+query.Encode(), http.StatusFound)
+}
+
+// token обменивает код авторизации на ID токен
+func (p *Provider) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "метод не поддерживается", http.StatusMethodNotAllowed)
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if ok {
+		clientID, _ = url.QueryUnescape(clientID)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+	}
+	if !ok || clientID != p.clientID || subtle.ConstantTimeCompare([]byte(clientSecret), []byte(p.clientSecret)) != 1 {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	if err := r.ParseForm(); err != nil || r.Form.Get("grant_type") != "authorization_code" {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	// Код одноразовый: удаляется при первой попытке обмена
+	p.mu.Lock()
+	auth, found := p.codes[r.Form.Get("code")]
+	delete(p.codes, r.Form.Get("code"))
+	modify := p.claims
+	p.mu.Unlock()
+
+	if !found || time.Now().After(auth.expiresAt) ||
+		auth.redirectURI != r.Form.Get("redirect_uri") ||
+		oidc.CodeChallenge(r.Form.Get("code_verifier")) != auth.codeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	subject := sha256.Sum256([]byte(auth.email))
+	claims := jwt.MapClaims{
+		"iss":            p.issuer,
+		"aud":            p.clientID,
+		"sub":            hex.EncodeToString(subject[:16]),
+		"email":          auth.email,
+		"email_verified": true,
+		"nonce":          auth.nonce,
+		"iat":            now.Unix(),
+		"exp":            now.Add(idTokenTTL).Unix(),
+	}
+	if modify != nil {
+		modify(claims)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = keyID
+
+	idToken, err := token.SignedString(p.privateKey)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": randomString(),
+		"token_type":   "Bearer",
+		"expires_in":   int(idTokenTTL.Seconds()),
+		"id_token":     idToken,
+	})
+}
+
+// jwks отдаёт открытый ключ подписи ID токенов
+func (p *Provider) jwks(w http.ResponseWriter, r *http.Request) {
+	publicKey := p.privateKey.Public().(ed25519.PublicKey)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "OKP",
+			"crv": "Ed25519",
+			"alg": "EdDSA",
+			"use": "sig",
+			"kid": keyID,
+			"x":   base64.RawURLEncoding.EncodeToString(publicKey),
+		}},
+	})
+}
+
+// writeJSON отправляет JSON ответ
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// randomString генерирует случайную строку (128 бит)
+func randomString() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
@@ -0,0 +1,234 @@
+package oidc_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"auth-service/internal/oidc"
+	"auth-service/internal/oidc/oidctest"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	testClientID     = "student-employment"
+	testClientSecret = "secret"
+	testRedirectURI  = "http://gateway.test/api/auth/oidc/university/callback"
+)
+
+// newTestProvider запускает учебного провайдера и настраивает клиента для него
+func newTestProvider(t *testing.T) (*oidctest.Server, *oidc.Provider) {
+	t.Helper()
+	idp, err := oidctest.NewServer(testClientID, testClientSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(idp.Close)
+
+	return idp, oidc.NewProvider(oidc.Config{
+		Name:           "university",
+		Issuer:         idp.Issuer(),
+		ClientID:       testClientID,
+		ClientSecret:   testClientSecret,
+		StudentDomains: []string{"university.kz"},
+	})
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	_, provider := newTestProvider(t)
+
+	authURL, err := provider.AuthCodeURL(context.Background(), testRedirectURI, "state-1", "nonce-1", "verifier-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query := parsed.Query()
+	want := map[string]string{
+		"response_type":         "code",
+		"client_id":             testClientID,
+		"redirect_uri":          testRedirectURI,
+		"state":                 "state-1",
+		"nonce":                 "nonce-1",
+		"code_challenge":        oidc.CodeChallenge("verifier-1"),
+		"code_challenge_method": "S256",
+	}
+	for name, value := range want {
+		if got := query.Get(name); got != value {
+			t.Errorf("%s = %q, want %q", name, got, value)
+		}
+	}
+	if query.Has("code_verifier") {
+		t.Error("code_verifier must not leave the relying party")
+	}
+}
+
+func TestExchange(t *testing.T) {
+	const (
+		verifier = "verifier-of-this-login"
+		nonce    = "nonce-of-this-login"
+	)
+
+	tests := []struct {
+		name        string
+		verifier    string              // code_verifier при обмене кода
+		nonce       string              // nonce, ожидаемый клиентом
+		redirectURI string              // redirect_uri при обмене кода
+		claims      func(jwt.MapClaims) // Изменение ID токена провайдером
+		wantErr     error
+	}{
+		{name: "valid login", verifier: verifier, nonce: nonce, redirectURI: testRedirectURI},
+		{name: "wrong code verifier", verifier: "intercepted-code", nonce: nonce, redirectURI: testRedirectURI, wantErr: oidc.ErrCodeExchange},
+		{name: "different redirect uri", verifier: verifier, nonce: nonce, redirectURI: "http://evil.test/callback", wantErr: oidc.ErrCodeExchange},
+		{name: "nonce of another login", verifier: verifier, nonce: "other-nonce", redirectURI: testRedirectURI, wantErr: oidc.ErrInvalidIDToken},
+		{
+			name: "token without nonce", verifier: verifier, nonce: nonce, redirectURI: testRedirectURI,
+			claims:  func(c jwt.MapClaims) { delete(c, "nonce") },
+			wantErr: oidc.ErrInvalidIDToken,
+		},
+		{
+			name: "token for another client", verifier: verifier, nonce: nonce, redirectURI: testRedirectURI,
+			claims:  func(c jwt.MapClaims) { c["aud"] = "other-client" },
+			wantErr: oidc.ErrInvalidIDToken,
+		},
+		{
+			name: "token of another issuer", verifier: verifier, nonce: nonce, redirectURI: testRedirectURI,
+			claims:  func(c jwt.MapClaims) { c["iss"] = "http://evil.test" },
+			wantErr: oidc.ErrInvalidIDToken,
+		},
+		{
+			name: "expired token", verifier: verifier, nonce: nonce, redirectURI: testRedirectURI,
+			claims:  func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() },
+			wantErr: oidc.ErrInvalidIDToken,
+		},
+		{
+			name: "token without subject", verifier: verifier, nonce: nonce, redirectURI: testRedirectURI,
+			claims:  func(c jwt.MapClaims) { delete(c, "sub") },
+			wantErr: oidc.ErrInvalidIDToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp, provider := newTestProvider(t)
+			idp.SetClaims(tt.claims)
+			ctx := context.Background()
+
+			authURL, err := provider.AuthCodeURL(ctx, testRedirectURI, "state", nonce, verifier)
+			if err != nil {
+				t.Fatal(err)
+			}
+			callback, err := idp.Login(authURL, "Student@University.kz")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			claims, err := provider.Exchange(ctx, tt.redirectURI, callback.Get("code"), tt.verifier, tt.nonce)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Exchange error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if claims.Email != "student@university.kz" || !claims.EmailVerified || claims.Subject == "" {
+				t.Errorf("claims = %+v", claims)
+			}
+		})
+	}
+}
+
+func TestExchangeCodeIsSingleUse(t *testing.T) {
+	idp, provider := newTestProvider(t)
+	ctx := context.Background()
+
+	authURL, err := provider.AuthCodeURL(ctx, testRedirectURI, "state", "nonce", "verifier")
+	if err != nil {
+		t.Fatal(err)
+	}
+	callback, err := idp.Login(authURL, "student@university.kz")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := provider.Exchange(ctx, testRedirectURI, callback.Get("code"), "verifier", "nonce"); err != nil {
+		t.Fatalf("first exchange: %v", err)
+	}
+	if _, err := provider.Exchange(ctx, testRedirectURI, callback.Get("code"), "verifier", "nonce"); !errors.Is(err, oidc.ErrCodeExchange) {
+		t.Errorf("second exchange error = %v, want %v", err, oidc.ErrCodeExchange)
+	}
+}
+
+func TestEmailVerifiedClaim(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  bool
+	}{
+		{"boolean true", true, true},
+		{"string true", "true", true},
+		{"boolean false", false, false},
+		{"string false", "false", false},
+		{"missing", nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idp, provider := newTestProvider(t)
+			idp.SetClaims(func(c jwt.MapClaims) {
+				if tt.value == nil {
+					delete(c, "email_verified")
+					return
+				}
+				c["email_verified"] = tt.value
+			})
+			ctx := context.Background()
+
+			authURL, err := provider.AuthCodeURL(ctx, testRedirectURI, "state", "nonce", "verifier")
+			if err != nil {
+				t.Fatal(err)
+			}
+			callback, err := idp.Login(authURL, "student@university.kz")
+			if err != nil {
+				t.Fatal(err)
+			}
+			claims, err := provider.Exchange(ctx, testRedirectURI, callback.Get("code"), "verifier", "nonce")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if claims.EmailVerified != tt.want {
+				t.Errorf("EmailVerified = %v, want %v", claims.EmailVerified, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsStudentDomain(t *testing.T) {
+	provider := oidc.NewProvider(oidc.Config{Name: "university", StudentDomains: []string{"University.kz", "stud.edu.kz"}})
+
+	tests := []struct {
+		email string
+		want  bool
+	}{
+		{"student@university.kz", true},
+		{"Student@UNIVERSITY.KZ", true},
+		{"student@mail.university.kz", true},
+		{"student@stud.edu.kz", true},
+		{"student@evil-university.kz", false},
+		{"student@university.kz.evil.com", false},
+		{"student@gmail.com", false},
+		{"university.kz", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := provider.IsStudentDomain(tt.email); got != tt.want {
+			t.Errorf("IsStudentDomain(%q) = %v, want %v", tt.email, got, tt.want)
+		}
+	}
+}
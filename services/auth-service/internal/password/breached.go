@@ -0,0 +1,111 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// prefixLength - длина префикса SHA-1 хеша, по которому выбирается диапазон (как в k-anonymity API)
+const prefixLength = 5
+
+// BreachedList проверяет пароль по списку скомпрометированных паролей
+type BreachedList interface {
+	Contains(password string) (bool, error)
+}
+
+// NewBreachedList открывает локальный список скомпрометированных паролей.
+// Поддерживаются два формата в стиле k-anonymity (SHA-1 в hex, регистр не важен, ":count" необязателен):
+//   - каталог с файлами диапазонов: файл с именем из первых 5 символов хеша содержит строки "SUFFIX[:count]";
+//     при проверке читается только один файл диапазона;
+//   - один файл со строками "HASH[:count]", который целиком загружается в память.
+func NewBreachedList(path string) (BreachedList, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка открытия списка скомпрометированных паролей: %w", err)
+	}
+	if info.IsDir() {
+		return &rangeDirectory{dir: path}, nil
+	}
+	return loadHashFile(path)
+}
+
+// rangeDirectory - каталог файлов диапазонов по префиксу хеша
+type rangeDirectory struct {
+	dir string
+}
+
+// Contains реализует BreachedList
+func (d *rangeDirectory) Contains(password string) (bool, error) {
+	prefix, suffix := splitHash(password)
+
+	file, err := os.Open(filepath.Join(d.dir, prefix))
+	if err != nil {
+		// Нет файла диапазона - нет скомпрометированных паролей с таким префиксом
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if hashPart(scanner.Text()) == suffix {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
+
+// hashSet - список хешей, загруженный в память
+type hashSet map[string]struct{}
+
+// loadHashFile загружает файл со строками "HASH[:count]"
+func loadHashFile(path string) (hashSet, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	set := make(hashSet)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		hash := hashPart(scanner.Text())
+		if hash == "" || strings.HasPrefix(hash, "#") {
+			continue
+		}
+		set[hash] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// Contains реализует BreachedList
+func (s hashSet) Contains(password string) (bool, error) {
+	prefix, suffix := splitHash(password)
+	_, ok := s[prefix+suffix]
+	return ok, nil
+}
+
+// splitHash возвращает префикс и остаток SHA-1 хеша пароля в верхнем регистре
+func splitHash(password string) (prefix, suffix string) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	return hash[:prefixLength], hash[prefixLength:]
+}
+
+// hashPart извлекает хеш из строки "HASH[:count]" и приводит его к верхнему регистру
+func hashPart(line string) string {
+	hash, _, _ := strings.Cut(strings.TrimSpace(line), ":")
+	return strings.ToUpper(hash)
+}
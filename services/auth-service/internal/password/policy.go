@@ -0,0 +1,121 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// bcryptMaxBytes - bcrypt учитывает только первые 72 байта пароля
+const bcryptMaxBytes = 72
+
+// Названия правил политики (используются как ключи в деталях ошибки)
+const (
+	RuleMinLength = "min_length"
+	RuleMaxLength = "max_length"
+	RuleLowercase = "lowercase"
+	RuleUppercase = "uppercase"
+	RuleDigit     = "digit"
+	RuleSpecial   = "special"
+	RuleEmail     = "email"
+	RuleBreached  = "breached"
+)
+
+// Violation описывает нарушенное правило политики
+type Violation struct {
+	Rule    string
+	Message string
+}
+
+// Policy описывает требования к паролю
+type Policy struct {
+	MinLength      int // Минимальная длина в символах
+	MaxBytes       int // Максимальная длина в байтах (не больше 72 - ограничение bcrypt)
+	RequireLower   bool
+	RequireUpper   bool
+	RequireDigit   bool
+	RequireSpecial bool
+	ForbidEmail    bool         // Запрет использовать имя из email в пароле
+	Breached       BreachedList // Список скомпрометированных паролей (nil - проверка отключена)
+}
+
+// Validate проверяет пароль и возвращает все нарушенные правила.
+// Ошибка возвращается только при сбое чтения списка скомпрометированных паролей.
+func (p *Policy) Validate(password, email string) ([]Violation, error) {
+	var violations []Violation
+	add := func(rule, message string) {
+		violations = append(violations, Violation{Rule: rule, Message: message})
+	}
+
+	// Длина
+	if utf8.RuneCountInString(password) < p.MinLength {
+		add(RuleMinLength, fmt.Sprintf("Пароль должен содержать не менее %d символов", p.MinLength))
+	}
+	if len(password) > p.maxBytes() {
+		add(RuleMaxLength, fmt.Sprintf("Пароль не должен превышать %d байт", p.maxBytes()))
+	}
+
+	// Классы символов
+	var hasLower, hasUpper, hasDigit, hasSpecial bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSpecial = true
+		}
+	}
+	if p.RequireLower && !hasLower {
+		add(RuleLowercase, "Пароль должен содержать строчную букву")
+	}
+	if p.RequireUpper && !hasUpper {
+		add(RuleUppercase, "Пароль должен содержать заглавную букву")
+	}
+	if p.RequireDigit && !hasDigit {
+		add(RuleDigit, "Пароль должен содержать цифру")
+	}
+	if p.RequireSpecial && !hasSpecial {
+		add(RuleSpecial, "Пароль должен содержать специальный символ")
+	}
+
+	// Имя из email (часть до @) в пароле
+	if p.ForbidEmail && containsEmail(password, email) {
+		add(RuleEmail, "Пароль не должен содержать ваш email")
+	}
+
+	// Список скомпрометированных паролей
+	if p.Breached != nil {
+		breached, err := p.Breached.Contains(password)
+		if err != nil {
+			return nil, err
+		}
+		if breached {
+			add(RuleBreached, "Этот пароль встречается в утечках данных, выберите другой")
+		}
+	}
+
+	return violations, nil
+}
+
+// maxBytes возвращает максимальную длину с учётом ограничения bcrypt
+func (p *Policy) maxBytes() int {
+	if p.MaxBytes <= 0 || p.MaxBytes > bcryptMaxBytes {
+		return bcryptMaxBytes
+	}
+	return p.MaxBytes
+}
+
+// containsEmail проверяет, содержит ли пароль имя из email (без учёта регистра).
+// Слишком короткие имена (меньше 3 символов) не проверяются.
+func containsEmail(password, email string) bool {
+	local, _, _ := strings.Cut(strings.ToLower(email), "@")
+	if utf8.RuneCountInString(local) < 3 {
+		return false
+	}
+	return strings.Contains(strings.ToLower(password), local)
+}
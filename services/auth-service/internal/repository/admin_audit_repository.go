@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AdminAuditRepository определяет интерфейс для работы с журналом действий администраторов
+type AdminAuditRepository interface {
+	Record(ctx context.Context, event *models.AdminAuditEvent) error
+	List(ctx context.Context, targetUserID uuid.UUID, limit, offset int) ([]models.AdminAuditEvent, int64, error)
+}
+
+// adminAuditRepository реализует AdminAuditRepository
+type adminAuditRepository struct {
+	db *gorm.DB
+}
+
+// NewAdminAuditRepository создаёт новый экземпляр репозитория журнала действий администраторов
+func NewAdminAuditRepository(db *gorm.DB) AdminAuditRepository {
+	return &adminAuditRepository{db: db}
+}
+
+// Record сохраняет событие журнала
+func (r *adminAuditRepository) Record(ctx context.Context, event *models.AdminAuditEvent) error {
+	return conn(ctx, r.db).Create(event).Error
+}
+
+// List возвращает страницу журнала (новые события первыми) и общее число событий.
+// uuid.Nil в targetUserID возвращает события по всем пользователям.
+func (r *adminAuditRepository) List(ctx context.Context, targetUserID uuid.UUID, limit, offset int) ([]models.AdminAuditEvent, int64, error) {
+	query := conn(ctx, r.db).Model(&models.AdminAuditEvent{})
+	if targetUserID != uuid.Nil {
+		query = query.Where("target_user_id = ?", targetUserID)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	events := make([]models.AdminAuditEvent, 0, limit)
+	err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&events).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
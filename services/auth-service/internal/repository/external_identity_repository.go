@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Ошибки репозитория внешних учётных записей
+var (
+	ErrExternalIdentityNotFound = errors.New("внешняя учётная запись не найдена")
+	ErrExternalIdentityExists   = errors.New("внешняя учётная запись уже связана")
+	ErrOIDCStateNotFound        = errors.New("вход через провайдера не найден или устарел")
+)
+
+// ExternalIdentityRepository определяет интерфейс для работы с внешними учётными записями
+// и незавершёнными входами через провайдеров OIDC
+type ExternalIdentityRepository interface {
+	FindByProviderSubject(ctx context.Context, provider, subject string) (*models.ExternalIdentity, error)
+	Create(ctx context.Context, identity *models.ExternalIdentity) error
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]models.ExternalIdentity, error)
+	TouchLogin(ctx context.Context, id uuid.UUID) error
+	CreateState(ctx context.Context, state *models.OIDCState) error
+	ConsumeState(ctx context.Context, stateHash string) (*models.OIDCState, error)
+}
+
+// externalIdentityRepository реализует ExternalIdentityRepository
+type externalIdentityRepository struct {
+	db *gorm.DB
+}
+
+// NewExternalIdentityRepository создаёт новый экземпляр репозитория внешних учётных записей
+func NewExternalIdentityRepository(db *gorm.DB) ExternalIdentityRepository {
+	return &externalIdentityRepository{db: db}
+}
+
+// FindByProviderSubject находит связь по провайдеру и идентификатору пользователя у него
+func (r *externalIdentityRepository) FindByProviderSubject(ctx context.Context, provider, subject string) (*models.ExternalIdentity, error) {
+	var identity models.ExternalIdentity
+	err := conn(ctx, r.db).Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrExternalIdentityNotFound
+		}
+		return nil, err
+	}
+	return &identity, nil
+}
+
+// Create сохраняет новую связь
+func (r *externalIdentityRepository) Create(ctx context.Context, identity *models.ExternalIdentity) error {
+	if err := conn(ctx, r.db).Create(identity).Error; err != nil {
+		// Параллельный первый вход той же учётной записи перехватывается уникальным индексом
+		if strings.Contains(err.Error(), "duplicate key") {
+			return ErrExternalIdentityExists
+		}
+		return err
+	}
+	return nil
+}
+
+// ListByUser возвращает внешние учётные записи, связанные с пользователем
+func (r *externalIdentityRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]models.ExternalIdentity, error) {
+	var identities []models.ExternalIdentity
+	err := conn(ctx, r.db).Where("user_id = ?", userID).Order("created_at").Find(&identities).Error
+	return identities, err
+}
+
+// TouchLogin обновляет время последнего входа через провайдера
+func (r *externalIdentityRepository) TouchLogin(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Model(&models.ExternalIdentity{}).
+		Where("id = ?", id).
+		Update("last_login_at", time.Now()).Error
+}
+
+// CreateState сохраняет параметры начатого входа и удаляет устаревшие
+func (r *externalIdentityRepository) CreateState(ctx context.Context, state *models.OIDCState) error {
+	if err := conn(ctx, r.db).Where("expires_at < ?", time.Now()).Delete(&models.OIDCState{}).Error; err != nil {
+		return err
+	}
+	return conn(ctx, r.db).Create(state).Error
+}
+
+// ConsumeState атомарно извлекает параметры входа: каждый state используется один раз
+func (r *externalIdentityRepository) ConsumeState(ctx context.Context, stateHash string) (*models.OIDCState, error) {
+	var states []models.OIDCState
+	err := conn(ctx, r.db).Raw("DELETE FROM oidc_states WHERE state_hash = ? AND expires_at > ? RETURNING *", stateHash, time.Now()).
+		Scan(&states).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 {
+		return nil, ErrOIDCStateNotFound
+	}
+	return &states[0], nil
+}
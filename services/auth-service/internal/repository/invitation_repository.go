@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Ошибки репозитория приглашений
+var (
+	ErrInvitationNotFound = errors.New("приглашение не найдено")
+	ErrInvitationUsed     = errors.New("приглашение уже использовано")
+)
+
+// InvitationRepository определяет интерфейс для работы с приглашениями в БД
+type InvitationRepository interface {
+	Create(ctx context.Context, invitation *models.Invitation) error
+	FindByHash(ctx context.Context, tokenHash string) (*models.Invitation, error)
+	MarkUsed(ctx context.Context, id uuid.UUID) error
+}
+
+// invitationRepository реализует InvitationRepository
+type invitationRepository struct {
+	db *gorm.DB
+}
+
+// NewInvitationRepository создаёт новый экземпляр репозитория приглашений
+func NewInvitationRepository(db *gorm.DB) InvitationRepository {
+	return &invitationRepository{db: db}
+}
+
+// Create сохраняет приглашение
+func (r *invitationRepository) Create(ctx context.Context, invitation *models.Invitation) error {
+	return conn(ctx, r.db).Create(invitation).Error
+}
+
+// FindByHash находит приглашение по хешу токена
+func (r *invitationRepository) FindByHash(ctx context.Context, tokenHash string) (*models.Invitation, error) {
+	var invitation models.Invitation
+	if err := conn(ctx, r.db).Where("token_hash = ?", tokenHash).First(&invitation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvitationNotFound
+		}
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// MarkUsed атомарно отмечает приглашение использованным.
+// Возвращает ErrInvitationUsed, если оно уже было использовано.
+func (r *invitationRepository) MarkUsed(ctx context.Context, id uuid.UUID) error {
+	result := conn(ctx, r.db).Model(&models.Invitation{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInvitationUsed
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// LockoutEventRepository определяет интерфейс для работы с журналом блокировок входа
+type LockoutEventRepository interface {
+	Record(ctx context.Context, event *models.LockoutEvent) error
+	List(ctx context.Context, limit, offset int) ([]models.LockoutEvent, int64, error)
+}
+
+// lockoutEventRepository реализует LockoutEventRepository
+type lockoutEventRepository struct {
+	db *gorm.DB
+}
+
+// NewLockoutEventRepository создаёт новый экземпляр репозитория журнала блокировок
+func NewLockoutEventRepository(db *gorm.DB) LockoutEventRepository {
+	return &lockoutEventRepository{db: db}
+}
+
+// Record сохраняет событие журнала
+func (r *lockoutEventRepository) Record(ctx context.Context, event *models.LockoutEvent) error {
+	return conn(ctx, r.db).Create(event).Error
+}
+
+// List возвращает страницу журнала (новые события первыми) и общее число событий
+func (r *lockoutEventRepository) List(ctx context.Context, limit, offset int) ([]models.LockoutEvent, int64, error) {
+	var total int64
+	if err := conn(ctx, r.db).Model(&models.LockoutEvent{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	events := make([]models.LockoutEvent, 0, limit)
+	err := conn(ctx, r.db).Order("created_at DESC").Limit(limit).Offset(offset).Find(&events).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Ошибки репозитория второго фактора
+var (
+	ErrMFANotFound          = errors.New("второй фактор не подключён")
+	ErrMFACodeReused        = errors.New("код уже использован")
+	ErrRecoveryCodeNotFound = errors.New("код восстановления не найден или уже использован")
+)
+
+// MFARepository определяет интерфейс для работы с TOTP-секретами, кодами восстановления
+// и требованиями второго фактора по ролям
+type MFARepository interface {
+	FindByUserID(ctx context.Context, userID uuid.UUID) (*models.UserMFA, error)
+	Save(ctx context.Context, mfa *models.UserMFA) error
+	Confirm(ctx context.Context, userID uuid.UUID, step int64) error
+	UseStep(ctx context.Context, userID uuid.UUID, step int64) error
+	Delete(ctx context.Context, userID uuid.UUID) error
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error
+	CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error)
+	IsRequiredForRole(ctx context.Context, role models.UserRole) (bool, error)
+	ListRolePolicies(ctx context.Context) ([]models.MFARolePolicy, error)
+	SaveRolePolicy(ctx context.Context, policy *models.MFARolePolicy) error
+}
+
+// mfaRepository реализует MFARepository
+type mfaRepository struct {
+	db *gorm.DB
+}
+
+// NewMFARepository создаёт новый экземпляр репозитория второго фактора
+func NewMFARepository(db *gorm.DB) MFARepository {
+	return &mfaRepository{db: db}
+}
+
+// FindByUserID находит TOTP-секрет пользователя
+func (r *mfaRepository) FindByUserID(ctx context.Context, userID uuid.UUID) (*models.UserMFA, error) {
+	var mfa models.UserMFA
+	if err := conn(ctx, r.db).Where("user_id = ?", userID).First(&mfa).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMFANotFound
+		}
+		return nil, err
+	}
+	return &mfa, nil
+}
+
+// Save создаёт или заменяет TOTP-секрет пользователя
+func (r *mfaRepository) Save(ctx context.Context, mfa *models.UserMFA) error {
+	return conn(ctx, r.db).Save(mfa).Error
+}
+
+// Confirm включает второй фактор и запоминает шаг кода, которым он подтверждён
+func (r *mfaRepository) Confirm(ctx context.Context, userID uuid.UUID, step int64) error {
+	return conn(ctx, r.db).Model(&models.UserMFA{}).
+		Where("user_id = ?", userID).
+		Updates(map[string]interface{}{
+			"confirmed_at":   time.Now(),
+			"last_used_step": step,
+		}).Error
+}
+
+// UseStep атомарно запоминает шаг принятого кода.
+// Если код этого или более позднего шага уже принимался, возвращается ErrMFACodeReused.
+func (r *mfaRepository) UseStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	result := conn(ctx, r.db).Model(&models.UserMFA{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrMFACodeReused
+	}
+	return nil
+}
+
+// Delete отключает второй фактор: удаляет секрет и коды восстановления
+func (r *mfaRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&models.MFARecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&models.UserMFA{}).Error
+	})
+}
+
+// ReplaceRecoveryCodes заменяет все коды восстановления пользователя новыми
+func (r *mfaRepository) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error {
+	codes := make([]models.MFARecoveryCode, 0, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes = append(codes, models.MFARecoveryCode{UserID: userID, CodeHash: hash})
+	}
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&models.MFARecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&codes).Error
+	})
+}
+
+// UseRecoveryCode атомарно помечает код восстановления использованным
+func (r *mfaRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	result := conn(ctx, r.db).Model(&models.MFARecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRecoveryCodeNotFound
+	}
+	return nil
+}
+
+// CountRecoveryCodes возвращает количество неиспользованных кодов восстановления
+func (r *mfaRepository) CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := conn(ctx, r.db).Model(&models.MFARecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// IsRequiredForRole проверяет, обязателен ли второй фактор для роли
+func (r *mfaRepository) IsRequiredForRole(ctx context.Context, role models.UserRole) (bool, error) {
+	var policy models.MFARolePolicy
+	if err := conn(ctx, r.db).Where("role = ?", role).First(&policy).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return policy.Required, nil
+}
+
+// ListRolePolicies возвращает сохранённые требования второго фактора по ролям
+func (r *mfaRepository) ListRolePolicies(ctx context.Context) ([]models.MFARolePolicy, error) {
+	var policies []models.MFARolePolicy
+	err := conn(ctx, r.db).Order("role").Find(&policies).Error
+	return policies, err
+}
+
+// SaveRolePolicy создаёт или обновляет требование второго фактора для роли
+func (r *mfaRepository) SaveRolePolicy(ctx context.Context, policy *models.MFARolePolicy) error {
+	return conn(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "role"}},
+		DoUpdates: clause.AssignmentColumns([]string{"required", "updated_by", "updated_at"}),
+	}).Create(policy).Error
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ErrProfileNotFound - профиль не найден
+var ErrProfileNotFound = errors.New("профиль не найден")
+
+// ProfileRepository определяет интерфейс для работы с ролевыми профилями в БД.
+// Профиль студента хранит student-service.
+type ProfileRepository interface {
+	FindEmployerProfile(ctx context.Context, userID uuid.UUID) (*models.EmployerProfile, error)
+	SaveEmployerProfile(ctx context.Context, profile *models.EmployerProfile) error
+	FindUniversityProfile(ctx context.Context, userID uuid.UUID) (*models.UniversityProfile, error)
+	SaveUniversityProfile(ctx context.Context, profile *models.UniversityProfile) error
+}
+
+// profileRepository реализует ProfileRepository
+type profileRepository struct {
+	db *gorm.DB
+}
+
+// NewProfileRepository создаёт новый экземпляр репозитория профилей
+func NewProfileRepository(db *gorm.DB) ProfileRepository {
+	return &profileRepository{db: db}
+}
+
+// FindEmployerProfile находит профиль работодателя по ID пользователя
+func (r *profileRepository) FindEmployerProfile(ctx context.Context, userID uuid.UUID) (*models.EmployerProfile, error) {
+	var profile models.EmployerProfile
+	if err := r.findByUserID(ctx, userID, &profile); err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// SaveEmployerProfile создаёт или обновляет профиль работодателя
+func (r *profileRepository) SaveEmployerProfile(ctx context.Context, profile *models.EmployerProfile) error {
+	return r.save(ctx, profile)
+}
+
+// FindUniversityProfile находит профиль университета по ID пользователя
+func (r *profileRepository) FindUniversityProfile(ctx context.Context, userID uuid.UUID) (*models.UniversityProfile, error) {
+	var profile models.UniversityProfile
+	if err := r.findByUserID(ctx, userID, &profile); err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// SaveUniversityProfile создаёт или обновляет профиль университета
+func (r *profileRepository) SaveUniversityProfile(ctx context.Context, profile *models.UniversityProfile) error {
+	return r.save(ctx, profile)
+}
+
+// findByUserID загружает профиль любого типа по ID пользователя
+func (r *profileRepository) findByUserID(ctx context.Context, userID uuid.UUID, dest interface{}) error {
+	if err := conn(ctx, r.db).Where("user_id = ?", userID).First(dest).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrProfileNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// save выполняет upsert профиля по первичному ключу user_id
+func (r *profileRepository) save(ctx context.Context, profile interface{}) error {
+	return conn(ctx, r.db).Save(profile).Error
+}
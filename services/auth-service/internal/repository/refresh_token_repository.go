@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Ошибки репозитория refresh токенов
+var (
+	ErrRefreshTokenNotFound = errors.New("refresh токен не найден")
+	ErrRefreshTokenUsed     = errors.New("refresh токен уже использован или отозван")
+)
+
+// RefreshTokenRepository определяет интерфейс для работы с refresh токенами в БД
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *models.RefreshToken) error
+	FindByID(ctx context.Context, id uuid.UUID) (*models.RefreshToken, error)
+	MarkRotated(ctx context.Context, id uuid.UUID) error
+}
+
+// refreshTokenRepository реализует RefreshTokenRepository
+type refreshTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewRefreshTokenRepository создаёт новый экземпляр репозитория refresh токенов
+func NewRefreshTokenRepository(db *gorm.DB) RefreshTokenRepository {
+	return &refreshTokenRepository{db: db}
+}
+
+// Create сохраняет выданный refresh токен
+func (r *refreshTokenRepository) Create(ctx context.Context, token *models.RefreshToken) error {
+	return conn(ctx, r.db).Create(token).Error
+}
+
+// FindByID находит refresh токен по идентификатору (jti)
+func (r *refreshTokenRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.RefreshToken, error) {
+	var token models.RefreshToken
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRefreshTokenNotFound
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+// MarkRotated атомарно помечает токен использованным.
+// Если токен уже был использован или отозван, возвращается ErrRefreshTokenUsed.
+func (r *refreshTokenRepository) MarkRotated(ctx context.Context, id uuid.UUID) error {
+	result := conn(ctx, r.db).Model(&models.RefreshToken{}).
+		Where("id = ? AND rotated_at IS NULL AND revoked_at IS NULL", id).
+		Update("rotated_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRefreshTokenUsed
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Ошибки репозитория сеансов
+var (
+	ErrSessionNotFound = errors.New("сеанс не найден")
+)
+
+// SessionRepository определяет интерфейс для работы с сеансами в БД
+type SessionRepository interface {
+	Create(ctx context.Context, session *models.Session) error
+	FindByID(ctx context.Context, id uuid.UUID) (*models.Session, error)
+	ListActiveByUser(ctx context.Context, userID uuid.UUID) ([]models.Session, error)
+	Touch(ctx context.Context, id uuid.UUID, ipAddress, userAgent string, expiresAt time.Time) error
+	Revoke(ctx context.Context, id uuid.UUID) error
+	RevokeAllForUser(ctx context.Context, userID uuid.UUID, exceptID uuid.UUID) error
+}
+
+// sessionRepository реализует SessionRepository
+type sessionRepository struct {
+	db *gorm.DB
+}
+
+// NewSessionRepository создаёт новый экземпляр репозитория сеансов
+func NewSessionRepository(db *gorm.DB) SessionRepository {
+	return &sessionRepository{db: db}
+}
+
+// Create создаёт новый сеанс
+func (r *sessionRepository) Create(ctx context.Context, session *models.Session) error {
+	return conn(ctx, r.db).Create(session).Error
+}
+
+// FindByID находит сеанс по UUID
+func (r *sessionRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.Session, error) {
+	var session models.Session
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&session).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSessionNotFound
+		}
+		return nil, err
+	}
+	return &session, nil
+}
+
+// ListActiveByUser возвращает действующие сеансы пользователя, начиная с последнего использованного
+func (r *sessionRepository) ListActiveByUser(ctx context.Context, userID uuid.UUID) ([]models.Session, error) {
+	sessions := make([]models.Session, 0)
+	err := conn(ctx, r.db).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_used_at DESC").
+		Find(&sessions).Error
+	if err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// Touch обновляет время последнего использования, адрес клиента и срок действия сеанса
+func (r *sessionRepository) Touch(ctx context.Context, id uuid.UUID, ipAddress, userAgent string, expiresAt time.Time) error {
+	return conn(ctx, r.db).Model(&models.Session{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"ip_address":   ipAddress,
+			"user_agent":   userAgent,
+			"expires_at":   expiresAt,
+			"last_used_at": time.Now(),
+		}).Error
+}
+
+// Revoke отзывает сеанс и все его refresh токены
+func (r *sessionRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	return r.revoke(ctx, "id = ?", id)
+}
+
+// RevokeAllForUser отзывает все сеансы пользователя, кроме exceptID (uuid.Nil - без исключений)
+func (r *sessionRepository) RevokeAllForUser(ctx context.Context, userID uuid.UUID, exceptID uuid.UUID) error {
+	return r.revoke(ctx, "user_id = ? AND id <> ?", userID, exceptID)
+}
+
+// revoke отзывает сеансы, удовлетворяющие условию, вместе с их refresh токенами в одной транзакции
+func (r *sessionRepository) revoke(ctx context.Context, query string, args ...interface{}) error {
+	now := time.Now()
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		sessionIDs := tx.Model(&models.Session{}).Select("id").Where(query, args...)
+
+		err := tx.Model(&models.RefreshToken{}).
+			Where("session_id IN (?) AND revoked_at IS NULL", sessionIDs).
+			Update("revoked_at", now).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&models.Session{}).
+			Where(query, args...).
+			Where("revoked_at IS NULL").
+			Update("revoked_at", now).Error
+	})
+}
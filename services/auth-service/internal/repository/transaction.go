@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// Transactor выполняет несколько операций репозиториев в одной транзакции БД
+type Transactor interface {
+	// InTransaction вызывает fn в транзакции: репозитории, получившие переданный
+	// в fn контекст, пишут в неё. Ошибка fn откатывает все изменения.
+	InTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// txKey - ключ контекста для текущей транзакции
+type txKey struct{}
+
+// transactor реализует Transactor
+type transactor struct {
+	db *gorm.DB
+}
+
+// NewTransactor создаёт новый экземпляр Transactor
+func NewTransactor(db *gorm.DB) Transactor {
+	return &transactor{db: db}
+}
+
+// InTransaction выполняет fn в транзакции; вложенный вызов использует внешнюю транзакцию
+func (t *transactor) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// conn возвращает соединение для запроса: транзакцию из контекста, если она открыта, иначе db
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
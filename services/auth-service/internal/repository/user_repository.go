@@ -2,7 +2,10 @@ package repository
 
 import (
 	"auth-service/internal/models"
+	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -16,12 +19,23 @@ var (
 
 // UserRepository определяет интерфейс для работы с пользователями в БД
 type UserRepository interface {
-	Create(user *models.User) error
-	FindByID(id uuid.UUID) (*models.User, error)
-	FindByEmail(email string) (*models.User, error)
-	Update(user *models.User) error
-	Delete(id uuid.UUID) error
-	ExistsByEmail(email string) (bool, error)
+	Create(ctx context.Context, user *models.User) error
+	FindByID(ctx context.Context, id uuid.UUID) (*models.User, error)
+	FindByEmail(ctx context.Context, email string) (*models.User, error)
+	Update(ctx context.Context, user *models.User) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ExistsByEmail(ctx context.Context, email string) (bool, error)
+	List(ctx context.Context, filter UserFilter, limit, offset int) ([]models.User, int64, error)
+}
+
+// UserFilter содержит условия поиска пользователей; пустые поля не ограничивают выборку
+type UserFilter struct {
+	Query       string // Часть email
+	Role        models.UserRole
+	Approval    models.ApprovalStatus
+	IsActive    *bool
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
 }
 
 // userRepository реализует UserRepository
@@ -35,9 +49,9 @@ func NewUserRepository(db *gorm.DB) UserRepository {
 }
 
 // Create создаёт нового пользователя в базе данных
-func (r *userRepository) Create(user *models.User) error {
+func (r *userRepository) Create(ctx context.Context, user *models.User) error {
 	// Проверка на существование пользователя с таким email
-	exists, err := r.ExistsByEmail(user.Email)
+	exists, err := r.ExistsByEmail(ctx, user.Email)
 	if err != nil {
 		return err
 	}
@@ -46,7 +60,7 @@ func (r *userRepository) Create(user *models.User) error {
 	}
 
 	// Создание записи в БД
-	if err := r.db.Create(user).Error; err != nil {
+	if err := conn(ctx, r.db).Create(user).Error; err != nil {
 		return err
 	}
 
@@ -54,9 +68,9 @@ func (r *userRepository) Create(user *models.User) error {
 }
 
 // FindByID находит пользователя по UUID
-func (r *userRepository) FindByID(id uuid.UUID) (*models.User, error) {
+func (r *userRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.User, error) {
 	var user models.User
-	if err := r.db.Where("id = ?", id).First(&user).Error; err != nil {
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrUserNotFound
 		}
@@ -66,9 +80,9 @@ func (r *userRepository) FindByID(id uuid.UUID) (*models.User, error) {
 }
 
 // FindByEmail находит пользователя по email
-func (r *userRepository) FindByEmail(email string) (*models.User, error) {
+func (r *userRepository) FindByEmail(ctx context.Context, email string) (*models.User, error) {
 	var user models.User
-	if err := r.db.Where("email = ?", email).First(&user).Error; err != nil {
+	if err := conn(ctx, r.db).Where("email = ?", email).First(&user).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrUserNotFound
 		}
@@ -78,8 +92,8 @@ func (r *userRepository) FindByEmail(email string) (*models.User, error) {
 }
 
 // Update обновляет данные пользователя
-func (r *userRepository) Update(user *models.User) error {
-	result := r.db.Save(user)
+func (r *userRepository) Update(ctx context.Context, user *models.User) error {
+	result := conn(ctx, r.db).Save(user)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -89,9 +103,9 @@ func (r *userRepository) Update(user *models.User) error {
 	return nil
 }
 
-// Delete удаляет пользователя по UUID
-func (r *userRepository) Delete(id uuid.UUID) error {
-	result := r.db.Delete(&models.User{}, "id = ?", id)
+// Delete удаляет пользователя по UUID (мягкое удаление)
+func (r *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := conn(ctx, r.db).Delete(&models.User{}, "id = ?", id)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -101,11 +115,49 @@ func (r *userRepository) Delete(id uuid.UUID) error {
 	return nil
 }
 
-// ExistsByEmail проверяет существование пользователя с указанным email
-func (r *userRepository) ExistsByEmail(email string) (bool, error) {
+// ExistsByEmail проверяет существование пользователя с указанным email.
+// Учитываются и удалённые пользователи: их email остаётся занятым.
+func (r *userRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	var count int64
-	if err := r.db.Model(&models.User{}).Where("email = ?", email).Count(&count).Error; err != nil {
+	if err := conn(ctx, r.db).Unscoped().Model(&models.User{}).Where("email = ?", email).Count(&count).Error; err != nil {
 		return false, err
 	}
 	return count > 0, nil
 }
+
+// List возвращает страницу пользователей по фильтру (новые первыми) и общее число найденных
+func (r *userRepository) List(ctx context.Context, filter UserFilter, limit, offset int) ([]models.User, int64, error) {
+	query := conn(ctx, r.db).Model(&models.User{})
+	if filter.Query != "" {
+		// Символы шаблона LIKE в запросе ищутся как обычные символы
+		escaped := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(filter.Query)
+		query = query.Where("email ILIKE ?", "%"+escaped+"%")
+	}
+	if filter.Role != "" {
+		query = query.Where("role = ?", filter.Role)
+	}
+	if filter.Approval != "" {
+		query = query.Where("approval_status = ?", filter.Approval)
+	}
+	if filter.IsActive != nil {
+		query = query.Where("is_active = ?", *filter.IsActive)
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where("created_at < ?", *filter.CreatedTo)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	users := make([]models.User, 0, limit)
+	err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&users).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
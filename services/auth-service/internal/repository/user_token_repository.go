@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"auth-service/internal/models"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Ошибки репозитория одноразовых токенов
+var (
+	ErrUserTokenNotFound = errors.New("токен не найден")
+	ErrUserTokenUsed     = errors.New("токен уже использован")
+)
+
+// UserTokenRepository определяет интерфейс для работы с одноразовыми токенами в БД
+type UserTokenRepository interface {
+	Create(ctx context.Context, token *models.UserToken) error
+	FindByID(ctx context.Context, id uuid.UUID) (*models.UserToken, error)
+	FindByHash(ctx context.Context, tokenHash string, purpose models.UserTokenPurpose) (*models.UserToken, error)
+	FindLatest(ctx context.Context, userID uuid.UUID, purpose models.UserTokenPurpose) (*models.UserToken, error)
+	MarkUsed(ctx context.Context, id uuid.UUID) error
+	InvalidateAll(ctx context.Context, userID uuid.UUID, purpose models.UserTokenPurpose) error
+}
+
+// userTokenRepository реализует UserTokenRepository
+type userTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewUserTokenRepository создаёт новый экземпляр репозитория одноразовых токенов
+func NewUserTokenRepository(db *gorm.DB) UserTokenRepository {
+	return &userTokenRepository{db: db}
+}
+
+// Create сохраняет выданный токен
+func (r *userTokenRepository) Create(ctx context.Context, token *models.UserToken) error {
+	return conn(ctx, r.db).Create(token).Error
+}
+
+// FindByID находит токен по идентификатору
+func (r *userTokenRepository) FindByID(ctx context.Context, id uuid.UUID) (*models.UserToken, error) {
+	var token models.UserToken
+	if err := conn(ctx, r.db).Where("id = ?", id).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserTokenNotFound
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+// FindByHash находит токен указанного назначения по его хешу
+func (r *userTokenRepository) FindByHash(ctx context.Context, tokenHash string, purpose models.UserTokenPurpose) (*models.UserToken, error) {
+	var token models.UserToken
+	if err := conn(ctx, r.db).Where("token_hash = ? AND purpose = ?", tokenHash, purpose).First(&token).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserTokenNotFound
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+// FindLatest находит последний выданный пользователю токен указанного назначения
+func (r *userTokenRepository) FindLatest(ctx context.Context, userID uuid.UUID, purpose models.UserTokenPurpose) (*models.UserToken, error) {
+	var token models.UserToken
+	err := conn(ctx, r.db).
+		Where("user_id = ? AND purpose = ?", userID, purpose).
+		Order("created_at DESC").
+		First(&token).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserTokenNotFound
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+// MarkUsed атомарно помечает токен использованным.
+// Если токен уже был использован, возвращается ErrUserTokenUsed.
+func (r *userTokenRepository) MarkUsed(ctx context.Context, id uuid.UUID) error {
+	result := conn(ctx, r.db).Model(&models.UserToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrUserTokenUsed
+	}
+	return nil
+}
+
+// InvalidateAll помечает использованными все действующие токены пользователя указанного назначения
+func (r *userTokenRepository) InvalidateAll(ctx context.Context, userID uuid.UUID, purpose models.UserTokenPurpose) error {
+	return conn(ctx, r.db).Model(&models.UserToken{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, purpose).
+		Update("used_at", time.Now()).Error
+}
@@ -3,21 +3,34 @@ package router
 import (
 	"auth-service/internal/dto"
 	"auth-service/internal/handler"
+	"auth-service/internal/service"
 	"auth-service/pkg/jwt"
 	"net/http"
+	"observability/health"
+	"observability/logging"
+	"observability/metrics"
+	"observability/tracing"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 // SetupRouter настраивает и возвращает роутер Gin
-func SetupRouter(authHandler *handler.AuthHandler, jwtManager *jwt.JWTManager) *gin.Engine {
-	// Создание роутера с стандартными middleware (Logger и Recovery)
-	r := gin.Default()
+func SetupRouter(authHandler *handler.AuthHandler, adminHandler *handler.AdminHandler, authService service.AuthService, jwtManager *jwt.JWTManager, healthHandler *health.Handler) *gin.Engine {
+	// Создание роутера: трассировка, журнал запросов и восстановление после паники
+	// (трассировка стоит до журнала, чтобы запись о запросе получила её идентификаторы,
+	// и до Recovery, чтобы операция завершилась и при панике обработчика)
+	r := gin.New()
+	r.Use(tracing.Middleware("auth-service")...)
+	r.Use(logging.Middleware(), gin.Recovery())
 
 	// Middleware для CORS
 	r.Use(corsMiddleware())
 
+	// Метрики количества и длительности запросов
+	r.Use(metrics.Middleware())
+
 	// Группа API маршрутов
 	api := r.Group("/api")
 	{
@@ -27,30 +40,84 @@ func SetupRouter(authHandler *handler.AuthHandler, jwtManager *jwt.JWTManager) *
 			// Публичные маршруты (не требуют аутентификации)
 			auth.POST("/register", authHandler.Register)
 			auth.POST("/login", authHandler.Login)
+			auth.POST("/login/mfa", authHandler.VerifyMFALogin)
+			auth.POST("/login/mfa/setup", authHandler.SetupMFAForLogin)
 			auth.POST("/refresh", authHandler.RefreshToken)
+			auth.POST("/verify-email", authHandler.VerifyEmail)
+			auth.POST("/password/forgot", authHandler.ForgotPassword)
+			auth.POST("/password/reset", authHandler.ResetPassword)
+			auth.GET("/oidc/providers", authHandler.ListOIDCProviders)
+			auth.GET("/oidc/:provider/login", authHandler.StartOIDCLogin)
+			auth.GET("/oidc/:provider/callback", authHandler.CompleteOIDCLogin)
+			auth.POST("/oidc/exchange", authHandler.ExchangeOIDCLoginCode)
 
 			// Защищённые маршруты (требуют JWT токен)
 			protected := auth.Group("")
-			protected.Use(authMiddleware(jwtManager))
+			protected.Use(authMiddleware(jwtManager, authService))
 			{
 				protected.GET("/me", authHandler.GetProfile)
+				protected.GET("/profile", authHandler.GetRoleProfile)
+				protected.PUT("/profile", authHandler.UpdateProfile)
+				protected.POST("/verify-email/resend", authHandler.ResendVerification)
+				protected.POST("/password/change", authHandler.ChangePassword)
+				protected.POST("/logout", authHandler.Logout)
+				protected.GET("/sessions", authHandler.ListSessions)
+				protected.DELETE("/sessions", authHandler.RevokeAllSessions)
+				protected.DELETE("/sessions/:id", authHandler.RevokeSession)
+				protected.GET("/mfa", authHandler.GetMFAStatus)
+				protected.POST("/mfa/setup", authHandler.SetupMFA)
+				protected.POST("/mfa/confirm", authHandler.ConfirmMFA)
+				protected.POST("/mfa/recovery-codes", authHandler.RegenerateRecoveryCodes)
+				protected.POST("/mfa/disable", authHandler.DisableMFA)
+			}
+
+			// Административные маршруты (только администраторы)
+			admin := auth.Group("/admin")
+			admin.Use(authMiddleware(jwtManager, authService), requireRoles("admin"))
+			{
+				admin.GET("/lockouts", adminHandler.ListLockoutEvents)
+				admin.POST("/lockouts/unlock", adminHandler.UnlockLogin)
+				admin.GET("/mfa/roles", adminHandler.ListMFARolePolicies)
+				admin.PUT("/mfa/roles/:role", adminHandler.SetMFARolePolicy)
+				admin.GET("/users", adminHandler.ListUsers)
+				admin.GET("/users/:id", adminHandler.GetUser)
+				admin.DELETE("/users/:id", adminHandler.DeleteUser)
+				admin.POST("/users/:id/activate", adminHandler.ActivateUser)
+				admin.POST("/users/:id/deactivate", adminHandler.DeactivateUser)
+				admin.PUT("/users/:id/role", adminHandler.ChangeUserRole)
+				admin.POST("/users/:id/password-reset", adminHandler.ForcePasswordReset)
+				admin.POST("/users/:id/approve", adminHandler.ApproveUser)
+				admin.POST("/users/:id/reject", adminHandler.RejectUser)
+				admin.POST("/invitations", adminHandler.CreateInvitation)
+				admin.GET("/audit", adminHandler.ListAdminAuditEvents)
 			}
 		}
 	}
 
-	// Health check эндпоинт
-	r.GET("/health", func(c *gin.Context) {
-		c.JSON(http.StatusOK, gin.H{
-			"status":  "healthy",
-			"service": "auth-service",
-		})
+	// Внутренние маршруты для других сервисов (API Gateway их не проксирует)
+	internal := r.Group("/internal")
+	{
+		internal.GET("/tokens/status", authHandler.GetTokenStatus)
+	}
+
+	// Открытые ключи для проверки подписи токенов (API Gateway кэширует их)
+	r.GET("/.well-known/jwks.json", func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(http.StatusOK, jwtManager.JWKS())
 	})
 
+	// Проверки состояния: живость процесса и готовность (база данных доступна, сервер не останавливается).
+	// /health оставлен для совместимости и равносилен /health/ready
+	r.GET("/health", healthHandler.Ready)
+	r.GET("/health/live", healthHandler.Live)
+	r.GET("/health/ready", healthHandler.Ready)
+
 	return r
 }
 
 // authMiddleware создаёт middleware для проверки JWT токена
-func authMiddleware(jwtManager *jwt.JWTManager) gin.HandlerFunc {
+// и того, что его сеанс не завершён, а учётная запись активна
+func authMiddleware(jwtManager *jwt.JWTManager, authService service.AuthService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Получение заголовка Authorization
 		authHeader := c.GetHeader("Authorization")
@@ -88,15 +155,61 @@ func authMiddleware(jwtManager *jwt.JWTManager) gin.HandlerFunc {
 			return
 		}
 
+		// Access токены выдаются только в рамках сеанса: токен без сеанса нельзя отозвать
+		sessionID, err := uuid.Parse(claims.SessionID)
+		if err != nil || sessionID == uuid.Nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
+				Error:   "Не авторизован",
+				Message: "Недействительный токен",
+			})
+			return
+		}
+
+		// Проверка отзыва: выход из системы и деактивация действуют немедленно
+		status, err := authService.GetTokenStatus(c.Request.Context(), claims.UserID, sessionID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, dto.ErrorResponse{
+				Error:   "Внутренняя ошибка сервера",
+				Message: "Не удалось проверить токен",
+			})
+			return
+		}
+		if !status.Active {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
+				Error:   "Не авторизован",
+				Message: "Сессия завершена, выполните вход повторно",
+			})
+			return
+		}
+
 		// Сохранение данных пользователя в контексте запроса
 		c.Set("user_id", claims.UserID)
 		c.Set("user_email", claims.Email)
 		c.Set("user_role", claims.Role)
+		c.Set("session_id", sessionID)
 
 		c.Next()
 	}
 }
 
+// requireRoles пропускает только пользователей с одной из указанных ролей
+func requireRoles(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := c.GetString("user_role")
+		for _, allowed := range roles {
+			if role == allowed {
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "Доступ запрещён",
+			Message: "Недостаточно прав для выполнения операции",
+		})
+	}
+}
+
 // corsMiddleware настраивает CORS для API
 func corsMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"auth-service/internal/dto"
+	"auth-service/internal/models"
+	"auth-service/internal/repository"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ErrCannotModifySelf - администратор пытается деактивировать, удалить или понизить сам себя
+var ErrCannotModifySelf = errors.New("нельзя изменить собственную учётную запись")
+
+// ListUsers возвращает страницу пользователей по фильтрам
+func (s *authService) ListUsers(ctx context.Context, req *dto.ListUsersRequest, page, limit int) (*dto.UserListResponse, error) {
+	filter := repository.UserFilter{
+		Query:       req.Query,
+		Role:        models.UserRole(req.Role),
+		Approval:    models.ApprovalStatus(req.Approval),
+		IsActive:    req.Active,
+		CreatedFrom: req.CreatedFrom,
+	}
+	// Дата окончания включается в период целиком
+	if req.CreatedTo != nil {
+		createdTo := req.CreatedTo.AddDate(0, 0, 1)
+		filter.CreatedTo = &createdTo
+	}
+
+	users, total, err := s.userRepo.List(ctx, filter, limit, (page-1)*limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.UserResponse, 0, len(users))
+	for i := range users {
+		items = append(items, dto.ToUserResponse(&users[i]))
+	}
+
+	return &dto.UserListResponse{
+		Items: items,
+		Total: total,
+		Page:  page,
+		Limit: limit,
+	}, nil
+}
+
+// GetUser возвращает подробные сведения о пользователе
+func (s *authService) GetUser(ctx context.Context, userID uuid.UUID) (*dto.AdminUserResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	mfaEnabled := false
+	mfa, err := s.mfaRepo.FindByUserID(ctx, user.ID)
+	switch {
+	case err == nil:
+		mfaEnabled = mfa.IsEnabled()
+	case !errors.Is(err, repository.ErrMFANotFound):
+		return nil, err
+	}
+
+	sessions, err := s.sessionRepo.ListActiveByUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := s.externalIdentityRepo.ListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	providers := make([]string, 0, len(identities))
+	for _, identity := range identities {
+		providers = append(providers, identity.Provider)
+	}
+
+	return &dto.AdminUserResponse{
+		UserResponse:   dto.ToUserResponse(user),
+		MFAEnabled:     mfaEnabled,
+		ActiveSessions: len(sessions),
+		OIDCProviders:  providers,
+	}, nil
+}
+
+// SetUserActive активирует или деактивирует учётную запись.
+// При деактивации все сеансы пользователя завершаются.
+func (s *authService) SetUserActive(ctx context.Context, actorID, userID uuid.UUID, active bool, req *dto.AdminActionRequest) (*dto.UserResponse, error) {
+	if actorID == userID && !active {
+		return nil, ErrCannotModifySelf
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Повторное действие не меняет состояние и не попадает в журнал
+	if user.IsActive == active {
+		response := dto.ToUserResponse(user)
+		return &response, nil
+	}
+
+	action := models.AdminActionActivate
+	if !active {
+		action = models.AdminActionDeactivate
+	}
+	user.IsActive = active
+	err = s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		if err := s.userRepo.Update(ctx, user); err != nil {
+			return err
+		}
+		if !active {
+			if err := s.sessionRepo.RevokeAllForUser(ctx, user.ID, uuid.Nil); err != nil {
+				return err
+			}
+		}
+		return s.recordAdminAction(ctx, actorID, user.ID, action, nil, req.Reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.ToUserResponse(user)
+	return &response, nil
+}
+
+// ChangeUserRole меняет роль пользователя. Роль записана в access токенах,
+// поэтому все сеансы пользователя завершаются и новая роль действует после входа.
+func (s *authService) ChangeUserRole(ctx context.Context, actorID, userID uuid.UUID, req *dto.ChangeRoleRequest) (*dto.UserResponse, error) {
+	if !req.Role.IsValid() {
+		return nil, ErrInvalidRole
+	}
+	if actorID == userID {
+		return nil, ErrCannotModifySelf
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == req.Role {
+		response := dto.ToUserResponse(user)
+		return &response, nil
+	}
+
+	details := map[string]string{"from": string(user.Role), "to": string(req.Role)}
+	user.Role = req.Role
+	err = s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		if err := s.userRepo.Update(ctx, user); err != nil {
+			return err
+		}
+		if err := s.sessionRepo.RevokeAllForUser(ctx, user.ID, uuid.Nil); err != nil {
+			return err
+		}
+		return s.recordAdminAction(ctx, actorID, user.ID, models.AdminActionChangeRole, details, req.Reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.ToUserResponse(user)
+	return &response, nil
+}
+
+// ForcePasswordReset заменяет пароль пользователя случайным, завершает все его сеансы
+// и отправляет ссылку для установки нового пароля
+func (s *authService) ForcePasswordReset(ctx context.Context, actorID, userID uuid.UUID, req *dto.AdminActionRequest) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	password, err := randomToken()
+	if err != nil {
+		return err
+	}
+	hashedPassword, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	user.PasswordHash = hashedPassword
+	err = s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		if err := s.userRepo.Update(ctx, user); err != nil {
+			return err
+		}
+		if err := s.sessionRepo.RevokeAllForUser(ctx, user.ID, uuid.Nil); err != nil {
+			return err
+		}
+		if err := s.userTokenRepo.InvalidateAll(ctx, user.ID, models.PurposePasswordReset); err != nil {
+			return err
+		}
+		return s.recordAdminAction(ctx, actorID, user.ID, models.AdminActionPasswordReset, nil, req.Reason)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Если письмо не дошло, пользователь может запросить сброс пароля самостоятельно
+	return s.sendPasswordResetEmail(ctx, user)
+}
+
+// DeleteUser мягко удаляет учётную запись: она скрывается из всех запросов,
+// вход становится невозможным, а email остаётся занятым
+func (s *authService) DeleteUser(ctx context.Context, actorID, userID uuid.UUID, req *dto.AdminActionRequest) error {
+	if actorID == userID {
+		return ErrCannotModifySelf
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	details := map[string]string{"email": user.Email, "role": string(user.Role)}
+	return s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		if err := s.sessionRepo.RevokeAllForUser(ctx, user.ID, uuid.Nil); err != nil {
+			return err
+		}
+		if err := s.userRepo.Delete(ctx, user.ID); err != nil {
+			return err
+		}
+		return s.recordAdminAction(ctx, actorID, user.ID, models.AdminActionDelete, details, req.Reason)
+	})
+}
+
+// ListAdminAuditEvents возвращает страницу журнала действий администраторов.
+// uuid.Nil в targetUserID возвращает события по всем пользователям.
+func (s *authService) ListAdminAuditEvents(ctx context.Context, targetUserID uuid.UUID, page, limit int) (*dto.AdminAuditEventListResponse, error) {
+	events, total, err := s.adminAuditRepo.List(ctx, targetUserID, limit, (page-1)*limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.AdminAuditEventResponse, 0, len(events))
+	for i := range events {
+		items = append(items, dto.ToAdminAuditEventResponse(&events[i]))
+	}
+
+	return &dto.AdminAuditEventListResponse{
+		Items: items,
+		Total: total,
+		Page:  page,
+		Limit: limit,
+	}, nil
+}
+
+// recordAdminAction добавляет запись в журнал действий администраторов.
+// Вызывается в транзакции вместе с самим действием, чтобы оно не осталось без записи.
+func (s *authService) recordAdminAction(ctx context.Context, actorID, targetUserID uuid.UUID, action models.AdminAction, details map[string]string, reason string) error {
+	return s.adminAuditRepo.Record(ctx, &models.AdminAuditEvent{
+		ActorID:      actorID,
+		TargetUserID: targetUserID,
+		Action:       action,
+		Details:      details,
+		Reason:       reason,
+	})
+}
@@ -0,0 +1,247 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"auth-service/internal/dto"
+	"auth-service/internal/models"
+	"auth-service/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// adminTestEnv - сервис с администратором и одним студентом
+type adminTestEnv struct {
+	service  *authService
+	users    *memoryUserRepository
+	sessions *memorySessionRepository
+	audit    *memoryAdminAuditRepository
+	adminID  uuid.UUID
+	user     *models.User
+}
+
+func newAdminTestEnv(t *testing.T) *adminTestEnv {
+	t.Helper()
+	env := &adminTestEnv{
+		users:    &memoryUserRepository{users: make(map[uuid.UUID]*models.User)},
+		sessions: &memorySessionRepository{},
+		audit:    &memoryAdminAuditRepository{},
+		adminID:  uuid.New(),
+		user: &models.User{
+			ID:       uuid.New(),
+			Email:    "student@example.com",
+			Role:     models.RoleStudent,
+			IsActive: true,
+		},
+	}
+	ctx := context.Background()
+	if err := env.users.Create(ctx, &models.User{ID: env.adminID, Email: "admin@example.com", Role: models.RoleAdmin, IsActive: true}); err != nil {
+		t.Fatal(err)
+	}
+	if err := env.users.Create(ctx, env.user); err != nil {
+		t.Fatal(err)
+	}
+
+	env.service = &authService{
+		userRepo:       env.users,
+		sessionRepo:    env.sessions,
+		adminAuditRepo: env.audit,
+		transactor:     &memoryTransactor{users: env.users, sessions: env.sessions, audit: env.audit},
+	}
+	return env
+}
+
+func TestSetUserActive(t *testing.T) {
+	tests := []struct {
+		name        string
+		self        bool // Администратор меняет собственную учётную запись
+		initial     bool // IsActive до действия
+		active      bool
+		wantErr     error
+		wantRevoked bool
+		wantAction  models.AdminAction // Пусто - запись в журнал не добавляется
+	}{
+		{name: "deactivate", initial: true, active: false, wantRevoked: true, wantAction: models.AdminActionDeactivate},
+		{name: "activate", initial: false, active: true, wantAction: models.AdminActionActivate},
+		{name: "repeated deactivate", initial: false, active: false},
+		{name: "repeated activate", initial: true, active: true},
+		{name: "deactivate self", self: true, initial: true, active: false, wantErr: ErrCannotModifySelf},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newAdminTestEnv(t)
+			env.users.users[env.user.ID].IsActive = tt.initial
+			target := env.user.ID
+			if tt.self {
+				target = env.adminID
+			}
+
+			_, err := env.service.SetUserActive(context.Background(), env.adminID, target, tt.active, &dto.AdminActionRequest{Reason: "test"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SetUserActive error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && env.users.users[target].IsActive != tt.active {
+				t.Errorf("IsActive = %v, want %v", env.users.users[target].IsActive, tt.active)
+			}
+			if revoked := len(env.sessions.revokedUsers) > 0; revoked != tt.wantRevoked {
+				t.Errorf("sessions revoked = %v, want %v", revoked, tt.wantRevoked)
+			}
+			assertAdminAction(t, env, tt.wantAction)
+		})
+	}
+}
+
+func TestChangeUserRole(t *testing.T) {
+	tests := []struct {
+		name       string
+		self       bool
+		role       models.UserRole
+		wantErr    error
+		wantRole   models.UserRole
+		wantAction models.AdminAction
+	}{
+		{name: "promote to employer", role: models.RoleEmployer, wantRole: models.RoleEmployer, wantAction: models.AdminActionChangeRole},
+		{name: "same role", role: models.RoleStudent, wantRole: models.RoleStudent},
+		{name: "unknown role", role: "superuser", wantErr: ErrInvalidRole, wantRole: models.RoleStudent},
+		{name: "demote self", self: true, role: models.RoleStudent, wantErr: ErrCannotModifySelf},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newAdminTestEnv(t)
+			target := env.user.ID
+			if tt.self {
+				target = env.adminID
+			}
+
+			_, err := env.service.ChangeUserRole(context.Background(), env.adminID, target, &dto.ChangeRoleRequest{Role: tt.role})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ChangeUserRole error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.self {
+				if env.users.users[env.adminID].Role != models.RoleAdmin {
+					t.Errorf("admin role changed to %s", env.users.users[env.adminID].Role)
+				}
+			} else if role := env.users.users[env.user.ID].Role; role != tt.wantRole {
+				t.Errorf("role = %s, want %s", role, tt.wantRole)
+			}
+
+			// Роль записана в access токенах: после смены все сеансы завершаются
+			if revoked := len(env.sessions.revokedUsers) > 0; revoked != (tt.wantAction != "") {
+				t.Errorf("sessions revoked = %v, want %v", revoked, tt.wantAction != "")
+			}
+			assertAdminAction(t, env, tt.wantAction)
+			if tt.wantAction != "" {
+				details := env.audit.events[0].Details
+				if details["from"] != string(models.RoleStudent) || details["to"] != string(tt.role) {
+					t.Errorf("details = %v", details)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	tests := []struct {
+		name       string
+		target     func(env *adminTestEnv) uuid.UUID
+		wantErr    error
+		wantAction models.AdminAction
+	}{
+		{name: "delete user", target: func(env *adminTestEnv) uuid.UUID { return env.user.ID }, wantAction: models.AdminActionDelete},
+		{name: "delete self", target: func(env *adminTestEnv) uuid.UUID { return env.adminID }, wantErr: ErrCannotModifySelf},
+		{name: "unknown user", target: func(*adminTestEnv) uuid.UUID { return uuid.New() }, wantErr: repository.ErrUserNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newAdminTestEnv(t)
+			target := tt.target(env)
+
+			err := env.service.DeleteUser(context.Background(), env.adminID, target, &dto.AdminActionRequest{Reason: "test"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeleteUser error = %v, want %v", err, tt.wantErr)
+			}
+
+			// Удаляется только цель действия; при отказе обе учётные записи остаются
+			_, studentExists := env.users.users[env.user.ID]
+			_, adminExists := env.users.users[env.adminID]
+			if studentExists == (tt.wantAction != "") || !adminExists {
+				t.Errorf("student exists = %v, admin exists = %v", studentExists, adminExists)
+			}
+			assertAdminAction(t, env, tt.wantAction)
+		})
+	}
+}
+
+func TestAdminActionWithoutAuditIsRolledBack(t *testing.T) {
+	tests := []struct {
+		name   string
+		action func(env *adminTestEnv) error
+	}{
+		{name: "deactivate", action: func(env *adminTestEnv) error {
+			_, err := env.service.SetUserActive(context.Background(), env.adminID, env.user.ID, false, &dto.AdminActionRequest{})
+			return err
+		}},
+		{name: "change role", action: func(env *adminTestEnv) error {
+			_, err := env.service.ChangeUserRole(context.Background(), env.adminID, env.user.ID, &dto.ChangeRoleRequest{Role: models.RoleEmployer})
+			return err
+		}},
+		{name: "delete", action: func(env *adminTestEnv) error {
+			return env.service.DeleteUser(context.Background(), env.adminID, env.user.ID, &dto.AdminActionRequest{})
+		}},
+		{name: "approve", action: func(env *adminTestEnv) error {
+			_, err := env.service.ApproveUser(context.Background(), env.adminID, env.user.ID, &dto.AdminActionRequest{})
+			return err
+		}},
+		{name: "reject", action: func(env *adminTestEnv) error {
+			_, err := env.service.RejectUser(context.Background(), env.adminID, env.user.ID, &dto.AdminActionRequest{})
+			return err
+		}},
+	}
+
+	auditErr := errors.New("audit log unavailable")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newAdminTestEnv(t)
+			env.audit.err = auditErr
+			env.users.users[env.user.ID].ApprovalStatus = models.ApprovalPending
+
+			if err := tt.action(env); !errors.Is(err, auditErr) {
+				t.Fatalf("error = %v, want %v", err, auditErr)
+			}
+
+			// Действие, не попавшее в журнал, не должно быть применено
+			user, ok := env.users.users[env.user.ID]
+			if !ok || !user.IsActive || user.Role != models.RoleStudent || user.ApprovalStatus != models.ApprovalPending {
+				t.Errorf("user = %+v (exists %v), want unchanged active student pending approval", user, ok)
+			}
+			if len(env.sessions.revokedUsers) != 0 {
+				t.Errorf("sessions revoked for %v, want none", env.sessions.revokedUsers)
+			}
+		})
+	}
+}
+
+// assertAdminAction проверяет, что в журнал записано ровно одно действие want над студентом
+// (или ничего, если want пусто)
+func assertAdminAction(t *testing.T, env *adminTestEnv, want models.AdminAction) {
+	t.Helper()
+	if want == "" {
+		if len(env.audit.events) != 0 {
+			t.Errorf("audit events = %+v, want none", env.audit.events)
+		}
+		return
+	}
+
+	if len(env.audit.events) != 1 {
+		t.Fatalf("audit events = %d, want 1", len(env.audit.events))
+	}
+	event := env.audit.events[0]
+	if event.Action != want || event.ActorID != env.adminID || event.TargetUserID != env.user.ID {
+		t.Errorf("audit event = %+v, want %s by admin on the student", event, want)
+	}
+}
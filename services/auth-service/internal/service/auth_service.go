@@ -2,10 +2,20 @@ package service
 
 import (
 	"auth-service/internal/dto"
+	"auth-service/internal/lockout"
+	"auth-service/internal/mail"
 	"auth-service/internal/models"
+	"auth-service/internal/oidc"
+	"auth-service/internal/password"
 	"auth-service/internal/repository"
 	"auth-service/pkg/jwt"
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"errors"
+	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
@@ -13,127 +23,287 @@ import (
 
 // Ошибки сервиса аутентификации
 var (
-	ErrInvalidCredentials = errors.New("неверный email или пароль")
-	ErrUserNotActive      = errors.New("учётная запись деактивирована")
-	ErrInvalidRole        = errors.New("недопустимая роль пользователя")
+	ErrInvalidCredentials   = errors.New("неверный email или пароль")
+	ErrUserNotActive        = errors.New("учётная запись деактивирована")
+	ErrInvalidRole          = errors.New("недопустимая роль пользователя")
+	ErrProfileNotSupported  = errors.New("для этой роли профиль не предусмотрен")
+	ErrRefreshTokenReused   = errors.New("повторное использование refresh токена, сессия отозвана")
+	ErrRefreshTokenRevoked  = errors.New("refresh токен отозван")
+	ErrSessionNotActive     = errors.New("сеанс завершён")
+	ErrEmailAlreadyVerified = errors.New("email уже подтверждён")
+	ErrVerificationTooSoon  = errors.New("письмо с подтверждением уже отправлено, повторите позже")
+	ErrInvalidResetToken    = errors.New("ссылка для сброса пароля недействительна или устарела")
+	ErrWrongPassword        = errors.New("неверный текущий пароль")
+	ErrSamePassword         = errors.New("новый пароль совпадает с текущим")
 )
 
 // AuthService определяет интерфейс сервиса аутентификации
 type AuthService interface {
-	Register(req *dto.RegisterRequest) (*dto.AuthResponse, error)
-	Login(req *dto.LoginRequest) (*dto.AuthResponse, error)
-	GetProfile(userID uuid.UUID) (*dto.UserResponse, error)
-	RefreshToken(refreshToken string) (*dto.TokenResponse, error)
+	Register(ctx context.Context, req *dto.RegisterRequest, client dto.ClientInfo) (*dto.AuthResponse, error)
+	Login(ctx context.Context, req *dto.LoginRequest, client dto.ClientInfo) (*dto.AuthResponse, error)
+	GetProfile(ctx context.Context, userID uuid.UUID) (*dto.UserResponse, error)
+	RefreshToken(ctx context.Context, refreshToken string, client dto.ClientInfo) (*dto.TokenResponse, error)
+	Logout(ctx context.Context, userID, sessionID uuid.UUID) error
+	ListSessions(ctx context.Context, userID, currentSessionID uuid.UUID) ([]dto.SessionResponse, error)
+	RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error
+	RevokeAllSessions(ctx context.Context, userID uuid.UUID) error
+	VerifyEmail(ctx context.Context, token string) (*dto.UserResponse, error)
+	ResendVerification(ctx context.Context, userID uuid.UUID) error
+	ForgotPassword(ctx context.Context, req *dto.ForgotPasswordRequest) error
+	ResetPassword(ctx context.Context, req *dto.ResetPasswordRequest) error
+	ChangePassword(ctx context.Context, userID, sessionID uuid.UUID, req *dto.ChangePasswordRequest) error
+	UnlockLogin(ctx context.Context, actorID uuid.UUID, req *dto.UnlockLoginRequest) error
+	ListLockoutEvents(ctx context.Context, page, limit int) (*dto.LockoutEventListResponse, error)
+	VerifyMFALogin(ctx context.Context, req *dto.MFALoginRequest, client dto.ClientInfo) (*dto.AuthResponse, error)
+	SetupMFAForLogin(ctx context.Context, req *dto.MFAChallengeRequest) (*dto.MFASetupResponse, error)
+	GetMFAStatus(ctx context.Context, userID uuid.UUID) (*dto.MFAStatusResponse, error)
+	SetupMFA(ctx context.Context, userID uuid.UUID) (*dto.MFASetupResponse, error)
+	ConfirmMFA(ctx context.Context, userID uuid.UUID, req *dto.MFACodeRequest) (*dto.MFARecoveryCodesResponse, error)
+	RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, req *dto.MFACodeRequest) (*dto.MFARecoveryCodesResponse, error)
+	DisableMFA(ctx context.Context, userID uuid.UUID, req *dto.DisableMFARequest) error
+	ListMFARolePolicies(ctx context.Context) ([]dto.MFARolePolicyResponse, error)
+	SetMFARolePolicy(ctx context.Context, actorID uuid.UUID, role models.UserRole, req *dto.MFARolePolicyRequest) (*dto.MFARolePolicyResponse, error)
+	ListOIDCProviders() []dto.OIDCProviderResponse
+	StartOIDCLogin(ctx context.Context, provider string) (string, string, error)
+	CompleteOIDCLogin(ctx context.Context, provider string, callback dto.OIDCCallbackRequest) (string, error)
+	ExchangeOIDCLoginCode(ctx context.Context, req *dto.OIDCExchangeRequest, client dto.ClientInfo) (*dto.AuthResponse, error)
+	ListUsers(ctx context.Context, req *dto.ListUsersRequest, page, limit int) (*dto.UserListResponse, error)
+	GetUser(ctx context.Context, userID uuid.UUID) (*dto.AdminUserResponse, error)
+	SetUserActive(ctx context.Context, actorID, userID uuid.UUID, active bool, req *dto.AdminActionRequest) (*dto.UserResponse, error)
+	ChangeUserRole(ctx context.Context, actorID, userID uuid.UUID, req *dto.ChangeRoleRequest) (*dto.UserResponse, error)
+	ForcePasswordReset(ctx context.Context, actorID, userID uuid.UUID, req *dto.AdminActionRequest) error
+	DeleteUser(ctx context.Context, actorID, userID uuid.UUID, req *dto.AdminActionRequest) error
+	ListAdminAuditEvents(ctx context.Context, targetUserID uuid.UUID, page, limit int) (*dto.AdminAuditEventListResponse, error)
+	CreateInvitation(ctx context.Context, actorID uuid.UUID, req *dto.InvitationRequest) (*dto.InvitationResponse, error)
+	ApproveUser(ctx context.Context, actorID, userID uuid.UUID, req *dto.AdminActionRequest) (*dto.UserResponse, error)
+	RejectUser(ctx context.Context, actorID, userID uuid.UUID, req *dto.AdminActionRequest) (*dto.UserResponse, error)
+	BootstrapAdmin(ctx context.Context, email, password string) (*dto.UserResponse, error)
+	GetTokenStatus(ctx context.Context, userID, sessionID uuid.UUID) (*dto.TokenStatusResponse, error)
+	GetRoleProfile(ctx context.Context, userID uuid.UUID) (*dto.ProfileResponse, error)
+	UpdateProfile(ctx context.Context, userID uuid.UUID, req *dto.UpdateProfileRequest) (*dto.ProfileResponse, error)
 }
 
 // authService реализует AuthService
 type authService struct {
-	userRepo   repository.UserRepository
-	jwtManager *jwt.JWTManager
+	userRepo             repository.UserRepository
+	profileRepo          repository.ProfileRepository
+	refreshTokenRepo     repository.RefreshTokenRepository
+	sessionRepo          repository.SessionRepository
+	userTokenRepo        repository.UserTokenRepository
+	lockoutEventRepo     repository.LockoutEventRepository
+	mfaRepo              repository.MFARepository
+	externalIdentityRepo repository.ExternalIdentityRepository
+	adminAuditRepo       repository.AdminAuditRepository
+	invitationRepo       repository.InvitationRepository
+	transactor           repository.Transactor
+	jwtManager           *jwt.JWTManager
+	mailSender           mail.Sender
+	options              Options
 }
 
+// Options содержит настройки сервиса аутентификации
+type Options struct {
+	AppURL               string        // Адрес веб-приложения для ссылок в письмах
+	EmailVerificationTTL time.Duration // Время жизни ссылки подтверждения email
+	PasswordResetTTL     time.Duration // Время жизни ссылки сброса пароля
+	InvitationTTL        time.Duration // Время жизни приглашения зарегистрироваться
+	PasswordPolicy       *password.Policy
+	LoginGuard           *lockout.Guard            // Защита входа от перебора паролей
+	MFAIssuer            string                    // Название сервиса в приложении-аутентификаторе
+	MFAChallengeTTL      time.Duration             // Время на ввод кода второго фактора после пароля
+	OIDCProviders        map[string]*oidc.Provider // Провайдеры входа (SSO) по имени
+	OIDCRedirectBaseURL  string                    // Внешний адрес API Gateway для возврата от провайдера
+}
+
+// dummyPasswordHash - bcrypt-хеш для сравнения, когда пользователь не найден
+var dummyPasswordHash = mustHashPassword("dummy-password-for-timing")
+
 // NewAuthService создаёт новый экземпляр сервиса аутентификации
-func NewAuthService(userRepo repository.UserRepository, jwtManager *jwt.JWTManager) AuthService {
+func NewAuthService(
+	userRepo repository.UserRepository,
+	profileRepo repository.ProfileRepository,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	sessionRepo repository.SessionRepository,
+	userTokenRepo repository.UserTokenRepository,
+	lockoutEventRepo repository.LockoutEventRepository,
+	mfaRepo repository.MFARepository,
+	externalIdentityRepo repository.ExternalIdentityRepository,
+	adminAuditRepo repository.AdminAuditRepository,
+	invitationRepo repository.InvitationRepository,
+	transactor repository.Transactor,
+	jwtManager *jwt.JWTManager,
+	mailSender mail.Sender,
+	options Options,
+) AuthService {
 	return &authService{
-		userRepo:   userRepo,
-		jwtManager: jwtManager,
+		userRepo:             userRepo,
+		profileRepo:          profileRepo,
+		refreshTokenRepo:     refreshTokenRepo,
+		sessionRepo:          sessionRepo,
+		userTokenRepo:        userTokenRepo,
+		lockoutEventRepo:     lockoutEventRepo,
+		mfaRepo:              mfaRepo,
+		externalIdentityRepo: externalIdentityRepo,
+		adminAuditRepo:       adminAuditRepo,
+		invitationRepo:       invitationRepo,
+		transactor:           transactor,
+		jwtManager:           jwtManager,
+		mailSender:           mailSender,
+		options:              options,
 	}
 }
 
-// Register регистрирует нового пользователя и возвращает JWT токены
-func (s *authService) Register(req *dto.RegisterRequest) (*dto.AuthResponse, error) {
-	// Валидация роли
-	if !req.Role.IsValid() {
-		return nil, ErrInvalidRole
+// Register регистрирует нового пользователя, отправляет ссылку подтверждения email
+// и возвращает JWT токены. До подтверждения email возможности пользователя ограничены.
+// Учётная запись, ожидающая одобрения администратором, возвращается без токенов.
+func (s *authService) Register(ctx context.Context, req *dto.RegisterRequest, client dto.ClientInfo) (*dto.AuthResponse, error) {
+	// Роль и необходимость одобрения определяются политикой регистрации
+	role, approval, invitation, err := s.registrationRole(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Проверка пароля по политике
+	if err := s.validatePassword("password", req.Password, req.Email); err != nil {
+		return nil, err
 	}
 
 	// Хеширование пароля с использованием bcrypt
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	hashedPassword, err := hashPassword(req.Password)
 	if err != nil {
 		return nil, err
 	}
 
 	// Создание нового пользователя
 	user := &models.User{
-		Email:        req.Email,
-		PasswordHash: string(hashedPassword),
-		Role:         req.Role,
-		IsActive:     true,
+		Email:          req.Email,
+		PasswordHash:   hashedPassword,
+		Role:           role,
+		IsActive:       true,
+		ApprovalStatus: approval,
+	}
+
+	if invitation != nil {
+		// Приглашение пришло на этот адрес, поэтому email считается подтверждённым
+		now := time.Now()
+		user.EmailVerified = true
+		user.EmailVerifiedAt = &now
+
+		// Занятый email не должен расходовать приглашение
+		exists, err := s.userRepo.ExistsByEmail(ctx, user.Email)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return nil, repository.ErrUserAlreadyExists
+		}
+		if err := s.invitationRepo.MarkUsed(ctx, invitation.ID); err != nil {
+			if errors.Is(err, repository.ErrInvitationUsed) {
+				return nil, ErrInvalidInvitation
+			}
+			return nil, err
+		}
 	}
 
 	// Сохранение в базе данных
-	if err := s.userRepo.Create(user); err != nil {
+	if err := s.userRepo.Create(ctx, user); err != nil {
 		return nil, err
 	}
 
-	// Генерация JWT токенов
-	accessToken, refreshToken, err := s.jwtManager.GenerateTokenPair(
-		user.ID,
-		user.Email,
-		string(user.Role),
-	)
+	// Отправка ссылки подтверждения email. Ошибка отправки не отменяет регистрацию:
+	// пользователь может запросить письмо повторно.
+	if !user.EmailVerified {
+		if err := s.sendVerificationEmail(ctx, user); err != nil {
+			slog.ErrorContext(ctx, "Ошибка отправки письма подтверждения", "user_id", user.ID, "error", err)
+		}
+	}
+
+	// До одобрения администратором вход невозможен
+	if user.ApprovalStatus != models.ApprovalApproved {
+		return &dto.AuthResponse{User: dto.ToUserResponse(user)}, nil
+	}
+
+	// Открытие нового сеанса и генерация JWT токенов
+	tokens, err := s.startSession(ctx, user, client)
 	if err != nil {
 		return nil, err
 	}
 
 	// Формирование ответа
 	return &dto.AuthResponse{
-		User: dto.ToUserResponse(user),
-		Tokens: dto.TokenResponse{
-			AccessToken:  accessToken,
-			RefreshToken: refreshToken,
-			TokenType:    "Bearer",
-			ExpiresIn:    s.jwtManager.GetAccessDuration(),
-		},
+		User:   dto.ToUserResponse(user),
+		Tokens: tokens,
 	}, nil
 }
 
 // Login аутентифицирует пользователя и возвращает JWT токены
-func (s *authService) Login(req *dto.LoginRequest) (*dto.AuthResponse, error) {
-	// Поиск пользователя по email
-	user, err := s.userRepo.FindByEmail(req.Email)
-	if err != nil {
-		if errors.Is(err, repository.ErrUserNotFound) {
-			return nil, ErrInvalidCredentials
-		}
+func (s *authService) Login(ctx context.Context, req *dto.LoginRequest, client dto.ClientInfo) (response *dto.AuthResponse, err error) {
+	defer func() { observeLogin(loginMethodPassword, response, err) }()
+
+	// Проверка временной блокировки после неудачных попыток
+	if err := s.options.LoginGuard.Check(ctx, req.Email, client.IPAddress); err != nil {
 		return nil, err
 	}
 
-	// Проверка активности учётной записи
+	// Проверка email и пароля. Для несуществующего пользователя хеш всё равно сравнивается,
+	// чтобы время ответа не выдавало, зарегистрирован ли email.
+	user, err := s.userRepo.FindByEmail(ctx, req.Email)
+	if err != nil && !errors.Is(err, repository.ErrUserNotFound) {
+		return nil, err
+	}
+	passwordHash := dummyPasswordHash
+	if user != nil {
+		passwordHash = user.PasswordHash
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(req.Password)); err != nil || user == nil {
+		if err := s.options.LoginGuard.RegisterFailure(ctx, req.Email, client.IPAddress); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidCredentials
+	}
+
+	// Проверка активности и одобрения учётной записи (сообщается только при верном пароле)
 	if !user.IsActive {
 		return nil, ErrUserNotActive
 	}
+	if err := checkApproval(user); err != nil {
+		return nil, err
+	}
 
-	// Проверка пароля
-	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
-		return nil, ErrInvalidCredentials
+	// Если нужен второй фактор, вместо токенов возвращается токен второго шага.
+	// Счётчик неудач при этом не сбрасывается: иначе повторный ввод известного пароля
+	// снимал бы ограничение на перебор кодов второго фактора.
+	challenge, err := s.mfaChallenge(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if challenge != nil {
+		return &dto.AuthResponse{
+			User: dto.ToUserResponse(user),
+			MFA:  challenge,
+		}, nil
 	}
 
-	// Генерация JWT токенов
-	accessToken, refreshToken, err := s.jwtManager.GenerateTokenPair(
-		user.ID,
-		user.Email,
-		string(user.Role),
-	)
+	if err := s.options.LoginGuard.RegisterSuccess(ctx, req.Email); err != nil {
+		return nil, err
+	}
+
+	// Открытие нового сеанса и генерация JWT токенов
+	tokens, err := s.startSession(ctx, user, client)
 	if err != nil {
 		return nil, err
 	}
 
 	// Формирование ответа
 	return &dto.AuthResponse{
-		User: dto.ToUserResponse(user),
-		Tokens: dto.TokenResponse{
-			AccessToken:  accessToken,
-			RefreshToken: refreshToken,
-			TokenType:    "Bearer",
-			ExpiresIn:    s.jwtManager.GetAccessDuration(),
-		},
+		User:   dto.ToUserResponse(user),
+		Tokens: tokens,
 	}, nil
 }
 
 // GetProfile возвращает профиль пользователя по ID
-func (s *authService) GetProfile(userID uuid.UUID) (*dto.UserResponse, error) {
-	user, err := s.userRepo.FindByID(userID)
+func (s *authService) GetProfile(ctx context.Context, userID uuid.UUID) (*dto.UserResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -142,16 +312,59 @@ func (s *authService) GetProfile(userID uuid.UUID) (*dto.UserResponse, error) {
 	return &response, nil
 }
 
-// RefreshToken обновляет access токен используя refresh токен
-func (s *authService) RefreshToken(refreshToken string) (*dto.TokenResponse, error) {
-	// Валидация refresh токена
+// RefreshToken обменивает refresh токен на новую пару токенов (ротация).
+// Предъявление уже обменянного токена считается признаком кражи:
+// весь сеанс отзывается и требуется повторный вход.
+func (s *authService) RefreshToken(ctx context.Context, refreshToken string, client dto.ClientInfo) (*dto.TokenResponse, error) {
+	// Валидация подписи и срока действия refresh токена
 	claims, err := s.jwtManager.ValidateRefreshToken(refreshToken)
 	if err != nil {
 		return nil, err
 	}
 
+	tokenID, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return nil, jwt.ErrInvalidToken
+	}
+
+	// Поиск токена в хранилище и сверка хеша
+	stored, err := s.refreshTokenRepo.FindByID(ctx, tokenID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+			return nil, jwt.ErrInvalidToken
+		}
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(stored.TokenHash), []byte(hashToken(refreshToken))) != 1 {
+		return nil, jwt.ErrInvalidToken
+	}
+
+	if stored.RevokedAt != nil {
+		return nil, ErrRefreshTokenRevoked
+	}
+
+	// Сеанс мог быть завершён пользователем (logout) или истечь
+	session, err := s.sessionRepo.FindByID(ctx, stored.SessionID)
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil, ErrSessionNotActive
+		}
+		return nil, err
+	}
+	if !session.IsActive() {
+		return nil, ErrSessionNotActive
+	}
+
+	// Атомарная отметка об использовании защищает от параллельного повторного обмена
+	if err := s.refreshTokenRepo.MarkRotated(ctx, stored.ID); err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenUsed) {
+			return nil, s.revokeReusedSession(ctx, stored)
+		}
+		return nil, err
+	}
+
 	// Проверка существования пользователя
-	user, err := s.userRepo.FindByID(claims.UserID)
+	user, err := s.userRepo.FindByID(ctx, stored.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -161,20 +374,80 @@ func (s *authService) RefreshToken(refreshToken string) (*dto.TokenResponse, err
 		return nil, ErrUserNotActive
 	}
 
-	// Генерация новой пары токенов
-	newAccessToken, newRefreshToken, err := s.jwtManager.GenerateTokenPair(
-		user.ID,
-		user.Email,
-		string(user.Role),
-	)
+	// Продление сеанса и генерация новой пары токенов в нём
+	expiresAt := time.Now().Add(s.jwtManager.GetRefreshDuration())
+	if err := s.sessionRepo.Touch(ctx, session.ID, client.IPAddress, client.UserAgent, expiresAt); err != nil {
+		return nil, err
+	}
+	return s.issueTokens(ctx, user, session.ID)
+}
+
+// startSession открывает новый сеанс пользователя и выдаёт первую пару токенов
+func (s *authService) startSession(ctx context.Context, user *models.User, client dto.ClientInfo) (*dto.TokenResponse, error) {
+	now := time.Now()
+	session := &models.Session{
+		UserID:     user.ID,
+		Device:     describeDevice(client.UserAgent),
+		IPAddress:  client.IPAddress,
+		UserAgent:  truncate(client.UserAgent, 512),
+		ExpiresAt:  now.Add(s.jwtManager.GetRefreshDuration()),
+		LastUsedAt: now,
+	}
+	if err := s.sessionRepo.Create(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(ctx, user, session.ID)
+}
+
+// issueTokens создаёт пару токенов и сохраняет хеш refresh токена в указанном сеансе
+func (s *authService) issueTokens(ctx context.Context, user *models.User, sessionID uuid.UUID) (*dto.TokenResponse, error) {
+	refreshTokenID := uuid.New()
+
+	subject := jwt.Subject{
+		UserID:        user.ID,
+		Email:         user.Email,
+		Role:          string(user.Role),
+		SessionID:     sessionID.String(),
+		EmailVerified: user.EmailVerified,
+	}
+
+	accessToken, refreshToken, err := s.jwtManager.GenerateTokenPair(subject, refreshTokenID.String())
+	if err != nil {
+		return nil, err
+	}
+	tokensIssuedTotal.WithLabelValues("access").Inc()
+	tokensIssuedTotal.WithLabelValues("refresh").Inc()
+
+	err = s.refreshTokenRepo.Create(ctx, &models.RefreshToken{
+		ID:        refreshTokenID,
+		UserID:    user.ID,
+		SessionID: sessionID,
+		TokenHash: hashToken(refreshToken),
+		ExpiresAt: time.Now().Add(s.jwtManager.GetRefreshDuration()),
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &dto.TokenResponse{
-		AccessToken:  newAccessToken,
-		RefreshToken: newRefreshToken,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
 		TokenType:    "Bearer",
 		ExpiresIn:    s.jwtManager.GetAccessDuration(),
 	}, nil
 }
+
+// revokeReusedSession отзывает сеанс, в котором повторно предъявлен refresh токен
+func (s *authService) revokeReusedSession(ctx context.Context, token *models.RefreshToken) error {
+	if err := s.sessionRepo.Revoke(ctx, token.SessionID); err != nil {
+		return err
+	}
+	return ErrRefreshTokenReused
+}
+
+// hashToken возвращает SHA-256 хеш токена в hex-представлении
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
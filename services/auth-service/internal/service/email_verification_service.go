@@ -0,0 +1,136 @@
+package service
+
+import (
+	"auth-service/internal/dto"
+	"auth-service/internal/mail"
+	"auth-service/internal/models"
+	"auth-service/internal/repository"
+	"auth-service/pkg/jwt"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// verificationResendInterval - минимальный интервал между письмами подтверждения
+const verificationResendInterval = time.Minute
+
+// VerifyEmail подтверждает email по токену из письма.
+// Токен одноразовый: повторное использование отклоняется.
+func (s *authService) VerifyEmail(ctx context.Context, token string) (*dto.UserResponse, error) {
+	// Проверка подписи, срока действия и типа токена
+	claims, err := s.jwtManager.ValidateEmailVerificationToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return nil, jwt.ErrInvalidToken
+	}
+
+	// Поиск токена в хранилище и сверка хеша
+	stored, err := s.userTokenRepo.FindByID(ctx, tokenID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserTokenNotFound) {
+			return nil, jwt.ErrInvalidToken
+		}
+		return nil, err
+	}
+	if stored.Purpose != models.PurposeEmailVerification || stored.UserID != claims.UserID ||
+		subtle.ConstantTimeCompare([]byte(stored.TokenHash), []byte(hashToken(token))) != 1 {
+		return nil, jwt.ErrInvalidToken
+	}
+
+	user, err := s.userRepo.FindByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ссылка действительна только для адреса, на который была отправлена
+	if user.Email != claims.Email {
+		return nil, jwt.ErrInvalidToken
+	}
+
+	// Атомарная отметка об использовании
+	if err := s.userTokenRepo.MarkUsed(ctx, stored.ID); err != nil {
+		return nil, err
+	}
+
+	if !user.EmailVerified {
+		now := time.Now()
+		user.EmailVerified = true
+		user.EmailVerifiedAt = &now
+		if err := s.userRepo.Update(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	response := dto.ToUserResponse(user)
+	return &response, nil
+}
+
+// ResendVerification отправляет новую ссылку подтверждения; предыдущие ссылки перестают действовать
+func (s *authService) ResendVerification(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.EmailVerified {
+		return ErrEmailAlreadyVerified
+	}
+
+	// Ограничение частоты отправки писем
+	latest, err := s.userTokenRepo.FindLatest(ctx, user.ID, models.PurposeEmailVerification)
+	if err != nil && !errors.Is(err, repository.ErrUserTokenNotFound) {
+		return err
+	}
+	if latest != nil && time.Since(latest.CreatedAt) < verificationResendInterval {
+		return ErrVerificationTooSoon
+	}
+
+	if err := s.userTokenRepo.InvalidateAll(ctx, user.ID, models.PurposeEmailVerification); err != nil {
+		return err
+	}
+
+	return s.sendVerificationEmail(ctx, user)
+}
+
+// sendVerificationEmail выпускает токен подтверждения и отправляет ссылку на email пользователя
+func (s *authService) sendVerificationEmail(ctx context.Context, user *models.User) error {
+	tokenID := uuid.New()
+	ttl := s.options.EmailVerificationTTL
+
+	token, err := s.jwtManager.GenerateEmailVerificationToken(user.ID, user.Email, tokenID.String(), ttl)
+	if err != nil {
+		return err
+	}
+
+	err = s.userTokenRepo.Create(ctx, &models.UserToken{
+		ID:        tokenID,
+		UserID:    user.ID,
+		Purpose:   models.PurposeEmailVerification,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/verify-email?token=%s", s.options.AppURL, url.QueryEscape(token))
+
+	return s.mailSender.Send(mail.Message{
+		To:      user.Email,
+		Subject: "Подтверждение email",
+		Body: fmt.Sprintf(
+			"Здравствуйте!\n\nДля подтверждения email перейдите по ссылке:\n%s\n\n"+
+				"Ссылка действительна %d ч. Если вы не регистрировались, проигнорируйте это письмо.\n",
+			link, int(ttl.Hours()),
+		),
+	})
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"auth-service/internal/dto"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// UnlockLogin снимает блокировку входа для учётной записи и (или) IP-адреса
+func (s *authService) UnlockLogin(ctx context.Context, actorID uuid.UUID, req *dto.UnlockLoginRequest) error {
+	if req.Email == "" && req.IPAddress == "" {
+		return &ValidationError{Details: map[string]string{
+			"email": "Укажите email или IP-адрес",
+		}}
+	}
+
+	return s.options.LoginGuard.Unlock(ctx, req.Email, req.IPAddress, actorID)
+}
+
+// ListLockoutEvents возвращает страницу журнала блокировок входа
+func (s *authService) ListLockoutEvents(ctx context.Context, page, limit int) (*dto.LockoutEventListResponse, error) {
+	events, total, err := s.lockoutEventRepo.List(ctx, limit, (page-1)*limit)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.LockoutEventResponse, 0, len(events))
+	for i := range events {
+		items = append(items, dto.ToLockoutEventResponse(&events[i]))
+	}
+
+	return &dto.LockoutEventListResponse{
+		Items: items,
+		Total: total,
+		Page:  page,
+		Limit: limit,
+	}, nil
+}
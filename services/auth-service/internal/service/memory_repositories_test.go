@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"auth-service/internal/models"
+	"auth-service/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// memoryUserRepository - пользователи в памяти; как и БД, отдаёт и сохраняет копии
+type memoryUserRepository struct {
+	repository.UserRepository
+	users map[uuid.UUID]*models.User
+}
+
+func (r *memoryUserRepository) Create(_ context.Context, user *models.User) error {
+	if user.ID == uuid.Nil {
+		user.ID = uuid.New()
+	}
+	stored := *user
+	r.users[user.ID] = &stored
+	return nil
+}
+
+func (r *memoryUserRepository) FindByID(_ context.Context, id uuid.UUID) (*models.User, error) {
+	if user, ok := r.users[id]; ok {
+		found := *user
+		return &found, nil
+	}
+	return nil, repository.ErrUserNotFound
+}
+
+func (r *memoryUserRepository) FindByEmail(_ context.Context, email string) (*models.User, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			found := *user
+			return &found, nil
+		}
+	}
+	return nil, repository.ErrUserNotFound
+}
+
+func (r *memoryUserRepository) Update(_ context.Context, user *models.User) error {
+	stored := *user
+	r.users[user.ID] = &stored
+	return nil
+}
+
+func (r *memoryUserRepository) Delete(_ context.Context, id uuid.UUID) error {
+	if _, ok := r.users[id]; !ok {
+		return repository.ErrUserNotFound
+	}
+	delete(r.users, id)
+	return nil
+}
+
+// memoryExternalIdentityRepository - связи с провайдерами и параметры входа в памяти
+type memoryExternalIdentityRepository struct {
+	repository.ExternalIdentityRepository
+	identities []*models.ExternalIdentity
+	states     map[string]*models.OIDCState
+}
+
+func (r *memoryExternalIdentityRepository) FindByProviderSubject(_ context.Context, provider, subject string) (*models.ExternalIdentity, error) {
+	for _, identity := range r.identities {
+		if identity.Provider == provider && identity.Subject == subject {
+			return identity, nil
+		}
+	}
+	return nil, repository.ErrExternalIdentityNotFound
+}
+
+func (r *memoryExternalIdentityRepository) Create(_ context.Context, identity *models.ExternalIdentity) error {
+	identity.ID = uuid.New()
+	r.identities = append(r.identities, identity)
+	return nil
+}
+
+func (r *memoryExternalIdentityRepository) TouchLogin(context.Context, uuid.UUID) error {
+	return nil
+}
+
+func (r *memoryExternalIdentityRepository) CreateState(_ context.Context, state *models.OIDCState) error {
+	r.states[state.StateHash] = state
+	return nil
+}
+
+func (r *memoryExternalIdentityRepository) ConsumeState(_ context.Context, stateHash string) (*models.OIDCState, error) {
+	state, ok := r.states[stateHash]
+	delete(r.states, stateHash)
+	if !ok || !state.ExpiresAt.After(time.Now()) {
+		return nil, repository.ErrOIDCStateNotFound
+	}
+	return state, nil
+}
+
+// memoryUserTokenRepository - одноразовые коды в памяти
+type memoryUserTokenRepository struct {
+	repository.UserTokenRepository
+	tokens []*models.UserToken
+}
+
+func (r *memoryUserTokenRepository) Create(_ context.Context, token *models.UserToken) error {
+	r.tokens = append(r.tokens, token)
+	return nil
+}
+
+// memorySessionRepository - учитывает завершение всех сеансов пользователя
+type memorySessionRepository struct {
+	repository.SessionRepository
+	revokedUsers []uuid.UUID
+}
+
+func (r *memorySessionRepository) RevokeAllForUser(_ context.Context, userID uuid.UUID, _ uuid.UUID) error {
+	r.revokedUsers = append(r.revokedUsers, userID)
+	return nil
+}
+
+// memoryAdminAuditRepository - журнал действий администраторов в памяти
+type memoryAdminAuditRepository struct {
+	repository.AdminAuditRepository
+	events []*models.AdminAuditEvent
+	err    error // Ошибка записи в журнал, если задана
+}
+
+func (r *memoryAdminAuditRepository) Record(_ context.Context, event *models.AdminAuditEvent) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.events = append(r.events, event)
+	return nil
+}
+
+// memoryTransactor - транзакция над репозиториями в памяти: при ошибке восстанавливает
+// пользователей, завершённые сеансы и журнал действий администраторов
+type memoryTransactor struct {
+	users    *memoryUserRepository
+	sessions *memorySessionRepository
+	audit    *memoryAdminAuditRepository
+}
+
+func (t *memoryTransactor) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	users := make(map[uuid.UUID]models.User, len(t.users.users))
+	for id, user := range t.users.users {
+		users[id] = *user
+	}
+	revoked, events := len(t.sessions.revokedUsers), len(t.audit.events)
+
+	err := fn(ctx)
+	if err == nil {
+		return nil
+	}
+
+	for id, user := range users {
+		if current, ok := t.users.users[id]; ok {
+			*current = user
+		} else {
+			t.users.users[id] = &user
+		}
+	}
+	for id := range t.users.users {
+		if _, ok := users[id]; !ok {
+			delete(t.users.users, id)
+		}
+	}
+	t.sessions.revokedUsers = t.sessions.revokedUsers[:revoked]
+	t.audit.events = t.audit.events[:events]
+	return err
+}
+
+// memoryLockoutEventRepository - журнал блокировок входа в памяти
+type memoryLockoutEventRepository struct {
+	repository.LockoutEventRepository
+	events []*models.LockoutEvent
+}
+
+func (r *memoryLockoutEventRepository) Record(_ context.Context, event *models.LockoutEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
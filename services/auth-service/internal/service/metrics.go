@@ -0,0 +1,39 @@
+package service
+
+import (
+	"auth-service/internal/dto"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Метрики входа и выдачи токенов
+var (
+	loginsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth_logins_total",
+		Help: "Login attempts by method (password, mfa, oidc) and result (success, mfa_required, failure).",
+	}, []string{"method", "result"})
+	tokensIssuedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth_tokens_issued_total",
+		Help: "Issued JWT tokens by type (access, refresh).",
+	}, []string{"type"})
+)
+
+// Способы входа в метрике auth_logins_total
+const (
+	loginMethodPassword = "password"
+	loginMethodMFA      = "mfa"
+	loginMethodOIDC     = "oidc"
+)
+
+// observeLogin учитывает попытку входа: успешную, требующую второго фактора или неудачную
+func observeLogin(method string, response *dto.AuthResponse, err error) {
+	result := "success"
+	switch {
+	case err != nil:
+		result = "failure"
+	case response != nil && response.MFA != nil:
+		result = "mfa_required"
+	}
+	loginsTotal.WithLabelValues(method, result).Inc()
+}
@@ -0,0 +1,460 @@
+package service
+
+import (
+	"auth-service/internal/dto"
+	"auth-service/internal/models"
+	"auth-service/internal/repository"
+	"auth-service/internal/totp"
+	"auth-service/pkg/jwt"
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Параметры кодов восстановления
+const (
+	recoveryCodeCount    = 10
+	recoveryCodeLength   = 8                                 // Символов без дефиса
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789" // Без похожих символов (0/o, 1/l/i)
+)
+
+// Ошибки второго фактора
+var (
+	ErrMFANotAvailable     = errors.New("второй фактор недоступен для этой роли")
+	ErrMFAAlreadyEnabled   = errors.New("второй фактор уже подключён")
+	ErrMFANotEnabled       = errors.New("второй фактор не подключён")
+	ErrMFASetupNotStarted  = errors.New("подключение второго фактора не начато")
+	ErrMFARequired         = errors.New("второй фактор обязателен для роли")
+	ErrInvalidMFACode      = errors.New("неверный код подтверждения")
+	ErrMFAChallengeInvalid = errors.New("время на ввод кода истекло, выполните вход повторно")
+)
+
+// mfaChallenge возвращает второй шаг входа, если у пользователя подключён второй фактор
+// или его требует роль; иначе nil
+func (s *authService) mfaChallenge(ctx context.Context, user *models.User) (*dto.MFAChallengeResponse, error) {
+	status := ""
+
+	mfa, err := s.mfaRepo.FindByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, repository.ErrMFANotFound) {
+		return nil, err
+	}
+	if mfa != nil && mfa.IsEnabled() {
+		status = dto.MFAStatusRequired
+	} else {
+		required, err := s.mfaRepo.IsRequiredForRole(ctx, user.Role)
+		if err != nil {
+			return nil, err
+		}
+		if required {
+			status = dto.MFAStatusSetupRequired
+		}
+	}
+
+	if status == "" {
+		return nil, nil
+	}
+
+	token, err := s.jwtManager.GenerateMFAChallengeToken(jwt.Subject{
+		UserID: user.ID,
+		Email:  user.Email,
+		Role:   string(user.Role),
+	}, s.options.MFAChallengeTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MFAChallengeResponse{
+		Status:         status,
+		ChallengeToken: token,
+		ExpiresIn:      int64(s.options.MFAChallengeTTL.Seconds()),
+	}, nil
+}
+
+// VerifyMFALogin завершает вход кодом второго фактора и открывает сеанс.
+// Если роль требует второй фактор, а он ещё не подключён, первый верный код подтверждает
+// подключение и в ответе возвращаются коды восстановления.
+func (s *authService) VerifyMFALogin(ctx context.Context, req *dto.MFALoginRequest, client dto.ClientInfo) (response *dto.AuthResponse, err error) {
+	defer func() { observeLogin(loginMethodMFA, response, err) }()
+
+	user, err := s.challengeUser(ctx, req.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Неверные коды учитываются той же защитой от перебора, что и пароли
+	if err := s.options.LoginGuard.Check(ctx, user.Email, client.IPAddress); err != nil {
+		return nil, err
+	}
+
+	mfa, err := s.mfaRepo.FindByUserID(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrMFANotFound) {
+			return nil, ErrMFASetupNotStarted
+		}
+		return nil, err
+	}
+
+	var recoveryCodes []string
+	switch {
+	case mfa.IsEnabled() && req.RecoveryCode != "":
+		err = s.useRecoveryCode(ctx, user.ID, req.RecoveryCode)
+	case mfa.IsEnabled():
+		err = s.verifyMFACode(ctx, mfa, req.Code)
+	default:
+		recoveryCodes, err = s.confirmMFA(ctx, mfa, req.Code)
+	}
+	if err != nil {
+		if errors.Is(err, ErrInvalidMFACode) {
+			if err := s.options.LoginGuard.RegisterFailure(ctx, user.Email, client.IPAddress); err != nil {
+				return nil, err
+			}
+		}
+		return nil, err
+	}
+
+	if err := s.options.LoginGuard.RegisterSuccess(ctx, user.Email); err != nil {
+		return nil, err
+	}
+
+	// Открытие нового сеанса и генерация JWT токенов
+	tokens, err := s.startSession(ctx, user, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.AuthResponse{
+		User:          dto.ToUserResponse(user),
+		Tokens:        tokens,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// SetupMFAForLogin начинает обязательное подключение второго фактора на втором шаге входа
+func (s *authService) SetupMFAForLogin(ctx context.Context, req *dto.MFAChallengeRequest) (*dto.MFASetupResponse, error) {
+	user, err := s.challengeUser(ctx, req.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+
+	required, err := s.mfaRepo.IsRequiredForRole(ctx, user.Role)
+	if err != nil {
+		return nil, err
+	}
+	if !required {
+		return nil, ErrMFANotAvailable
+	}
+
+	return s.beginMFASetup(ctx, user)
+}
+
+// GetMFAStatus возвращает состояние второго фактора пользователя
+func (s *authService) GetMFAStatus(ctx context.Context, userID uuid.UUID) (*dto.MFAStatusResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	required, err := s.mfaRepo.IsRequiredForRole(ctx, user.Role)
+	if err != nil {
+		return nil, err
+	}
+	response := &dto.MFAStatusResponse{Required: required}
+
+	mfa, err := s.mfaRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrMFANotFound) {
+			return response, nil
+		}
+		return nil, err
+	}
+
+	response.Enabled = mfa.IsEnabled()
+	response.Pending = !mfa.IsEnabled()
+	if response.Enabled {
+		if response.RecoveryCodesLeft, err = s.mfaRepo.CountRecoveryCodes(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// SetupMFA начинает подключение второго фактора: создаёт секрет для приложения-аутентификатора
+func (s *authService) SetupMFA(ctx context.Context, userID uuid.UUID) (*dto.MFASetupResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	available, err := s.mfaAvailable(ctx, user.Role)
+	if err != nil {
+		return nil, err
+	}
+	if !available {
+		return nil, ErrMFANotAvailable
+	}
+
+	return s.beginMFASetup(ctx, user)
+}
+
+// ConfirmMFA включает второй фактор первым кодом из приложения и выдаёт коды восстановления
+func (s *authService) ConfirmMFA(ctx context.Context, userID uuid.UUID, req *dto.MFACodeRequest) (*dto.MFARecoveryCodesResponse, error) {
+	mfa, err := s.mfaRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrMFANotFound) {
+			return nil, ErrMFASetupNotStarted
+		}
+		return nil, err
+	}
+
+	codes, err := s.confirmMFA(ctx, mfa, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.MFARecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// RegenerateRecoveryCodes заменяет коды восстановления новыми (требуется код из приложения)
+func (s *authService) RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, req *dto.MFACodeRequest) (*dto.MFARecoveryCodesResponse, error) {
+	mfa, err := s.enabledMFA(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.verifyMFACode(ctx, mfa, req.Code); err != nil {
+		return nil, err
+	}
+
+	codes, err := s.issueRecoveryCodes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.MFARecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// DisableMFA отключает второй фактор после проверки пароля и кода из приложения
+func (s *authService) DisableMFA(ctx context.Context, userID uuid.UUID, req *dto.DisableMFARequest) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.Password)); err != nil {
+		return ErrWrongPassword
+	}
+
+	required, err := s.mfaRepo.IsRequiredForRole(ctx, user.Role)
+	if err != nil {
+		return err
+	}
+	if required {
+		return ErrMFARequired
+	}
+
+	mfa, err := s.enabledMFA(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := s.verifyMFACode(ctx, mfa, req.Code); err != nil {
+		return err
+	}
+
+	return s.mfaRepo.Delete(ctx, userID)
+}
+
+// ListMFARolePolicies возвращает требования второго фактора для всех ролей
+func (s *authService) ListMFARolePolicies(ctx context.Context) ([]dto.MFARolePolicyResponse, error) {
+	policies, err := s.mfaRepo.ListRolePolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	required := make(map[models.UserRole]bool, len(policies))
+	for _, policy := range policies {
+		required[policy.Role] = policy.Required
+	}
+
+	roles := []models.UserRole{models.RoleStudent, models.RoleEmployer, models.RoleUniversity, models.RoleAdmin}
+	response := make([]dto.MFARolePolicyResponse, 0, len(roles))
+	for _, role := range roles {
+		response = append(response, dto.MFARolePolicyResponse{Role: role, Required: required[role]})
+	}
+	return response, nil
+}
+
+// SetMFARolePolicy делает второй фактор обязательным (или необязательным) для роли.
+// Пользователи роли без второго фактора подключат его при следующем входе.
+func (s *authService) SetMFARolePolicy(ctx context.Context, actorID uuid.UUID, role models.UserRole, req *dto.MFARolePolicyRequest) (*dto.MFARolePolicyResponse, error) {
+	if !role.IsValid() {
+		return nil, ErrInvalidRole
+	}
+
+	err := s.mfaRepo.SaveRolePolicy(ctx, &models.MFARolePolicy{
+		Role:      role,
+		Required:  *req.Required,
+		UpdatedBy: &actorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MFARolePolicyResponse{Role: role, Required: *req.Required}, nil
+}
+
+// challengeUser проверяет токен второго шага входа и возвращает активного пользователя
+func (s *authService) challengeUser(ctx context.Context, challengeToken string) (*models.User, error) {
+	claims, err := s.jwtManager.ValidateMFAChallengeToken(challengeToken)
+	if err != nil {
+		return nil, ErrMFAChallengeInvalid
+	}
+
+	user, err := s.userRepo.FindByID(ctx, claims.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, ErrMFAChallengeInvalid
+		}
+		return nil, err
+	}
+	if !user.IsActive {
+		return nil, ErrUserNotActive
+	}
+	return user, nil
+}
+
+// mfaAvailable проверяет, может ли роль подключить второй фактор:
+// он предусмотрен для работодателей и администраторов, а также для ролей, где он обязателен
+func (s *authService) mfaAvailable(ctx context.Context, role models.UserRole) (bool, error) {
+	if role == models.RoleEmployer || role == models.RoleAdmin {
+		return true, nil
+	}
+	return s.mfaRepo.IsRequiredForRole(ctx, role)
+}
+
+// beginMFASetup создаёт новый секрет (заменяя неподтверждённый) и ссылку для приложения
+func (s *authService) beginMFASetup(ctx context.Context, user *models.User) (*dto.MFASetupResponse, error) {
+	mfa, err := s.mfaRepo.FindByUserID(ctx, user.ID)
+	if err != nil && !errors.Is(err, repository.ErrMFANotFound) {
+		return nil, err
+	}
+	if mfa != nil && mfa.IsEnabled() {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mfaRepo.Save(ctx, &models.UserMFA{UserID: user.ID, Secret: secret}); err != nil {
+		return nil, err
+	}
+
+	return &dto.MFASetupResponse{
+		Secret:     secret,
+		OTPAuthURI: totp.URI(s.options.MFAIssuer, user.Email, secret),
+	}, nil
+}
+
+// confirmMFA подтверждает подключение кодом и выдаёт коды восстановления
+func (s *authService) confirmMFA(ctx context.Context, mfa *models.UserMFA, code string) ([]string, error) {
+	if mfa.IsEnabled() {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	step, ok := totp.Validate(mfa.Secret, code, time.Now())
+	if !ok {
+		return nil, ErrInvalidMFACode
+	}
+	if err := s.mfaRepo.Confirm(ctx, mfa.UserID, step); err != nil {
+		return nil, err
+	}
+
+	return s.issueRecoveryCodes(ctx, mfa.UserID)
+}
+
+// enabledMFA возвращает подключённый второй фактор пользователя
+func (s *authService) enabledMFA(ctx context.Context, userID uuid.UUID) (*models.UserMFA, error) {
+	mfa, err := s.mfaRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrMFANotFound) {
+			return nil, ErrMFANotEnabled
+		}
+		return nil, err
+	}
+	if !mfa.IsEnabled() {
+		return nil, ErrMFANotEnabled
+	}
+	return mfa, nil
+}
+
+// verifyMFACode проверяет код из приложения; каждый код принимается только один раз
+func (s *authService) verifyMFACode(ctx context.Context, mfa *models.UserMFA, code string) error {
+	step, ok := totp.Validate(mfa.Secret, code, time.Now())
+	if !ok {
+		return ErrInvalidMFACode
+	}
+	if err := s.mfaRepo.UseStep(ctx, mfa.UserID, step); err != nil {
+		if errors.Is(err, repository.ErrMFACodeReused) {
+			return ErrInvalidMFACode
+		}
+		return err
+	}
+	return nil
+}
+
+// useRecoveryCode принимает код восстановления; каждый код принимается только один раз
+func (s *authService) useRecoveryCode(ctx context.Context, userID uuid.UUID, code string) error {
+	err := s.mfaRepo.UseRecoveryCode(ctx, userID, hashToken(normalizeRecoveryCode(code)))
+	if errors.Is(err, repository.ErrRecoveryCodeNotFound) {
+		return ErrInvalidMFACode
+	}
+	return err
+}
+
+// issueRecoveryCodes создаёт новые коды восстановления; сохраняются только их хеши
+func (s *authService) issueRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+
+	for i := 0; i < recoveryCodeCount; i++ {
+		code, err := randomRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, hashToken(normalizeRecoveryCode(code)))
+	}
+
+	if err := s.mfaRepo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// randomRecoveryCode генерирует код восстановления вида "k7m2-x9qp"
+func randomRecoveryCode() (string, error) {
+	var b strings.Builder
+	alphabetSize := big.NewInt(int64(len(recoveryCodeAlphabet)))
+
+	for i := 0; i < recoveryCodeLength; i++ {
+		if i == recoveryCodeLength/2 {
+			b.WriteByte('-')
+		}
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(recoveryCodeAlphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
+
+// normalizeRecoveryCode приводит введённый код к виду, в котором хранится хеш
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
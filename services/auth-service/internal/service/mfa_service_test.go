@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"auth-service/internal/dto"
+	"auth-service/internal/lockout"
+	"auth-service/internal/models"
+	"auth-service/internal/repository"
+	"auth-service/internal/totp"
+	"auth-service/pkg/jwt"
+
+	"github.com/google/uuid"
+)
+
+// memoryMFARepository - второй фактор в памяти с теми же гарантиями, что и в PostgreSQL:
+// шаг кода принимается, только если он позже последнего, код восстановления - один раз
+type memoryMFARepository struct {
+	repository.MFARepository
+	mfa           map[uuid.UUID]*models.UserMFA
+	recoveryCodes map[uuid.UUID]map[string]bool // хеш -> использован
+}
+
+func newMemoryMFARepository() *memoryMFARepository {
+	return &memoryMFARepository{
+		mfa:           make(map[uuid.UUID]*models.UserMFA),
+		recoveryCodes: make(map[uuid.UUID]map[string]bool),
+	}
+}
+
+func (r *memoryMFARepository) FindByUserID(_ context.Context, userID uuid.UUID) (*models.UserMFA, error) {
+	if mfa, ok := r.mfa[userID]; ok {
+		return mfa, nil
+	}
+	return nil, repository.ErrMFANotFound
+}
+
+func (r *memoryMFARepository) UseStep(_ context.Context, userID uuid.UUID, step int64) error {
+	mfa, ok := r.mfa[userID]
+	if !ok || mfa.LastUsedStep >= step {
+		return repository.ErrMFACodeReused
+	}
+	mfa.LastUsedStep = step
+	return nil
+}
+
+func (r *memoryMFARepository) ReplaceRecoveryCodes(_ context.Context, userID uuid.UUID, codeHashes []string) error {
+	codes := make(map[string]bool, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes[hash] = false
+	}
+	r.recoveryCodes[userID] = codes
+	return nil
+}
+
+func (r *memoryMFARepository) UseRecoveryCode(_ context.Context, userID uuid.UUID, codeHash string) error {
+	used, ok := r.recoveryCodes[userID][codeHash]
+	if !ok || used {
+		return repository.ErrRecoveryCodeNotFound
+	}
+	r.recoveryCodes[userID][codeHash] = true
+	return nil
+}
+
+// newMFATestService создаёт сервис с подключённым вторым фактором пользователя
+func newMFATestService(t *testing.T) (*authService, *models.UserMFA) {
+	t.Helper()
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	confirmedAt := time.Now()
+	mfa := &models.UserMFA{UserID: uuid.New(), Secret: secret, ConfirmedAt: &confirmedAt}
+	repo := newMemoryMFARepository()
+	repo.mfa[mfa.UserID] = mfa
+
+	return &authService{mfaRepo: repo}, mfa
+}
+
+// mustCode возвращает код приложения-аутентификатора для момента at
+func mustCode(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+	code, err := totp.Code(secret, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+func TestVerifyMFACodeWindow(t *testing.T) {
+	tests := []struct {
+		name    string
+		offset  time.Duration // Сдвиг часов приложения относительно сервера
+		wantErr error
+	}{
+		{"in sync", 0, nil},
+		{"clock behind by one step", -30 * time.Second, nil},
+		{"clock ahead by one step", 30 * time.Second, nil},
+		{"clock behind by three steps", -90 * time.Second, ErrInvalidMFACode},
+		{"clock ahead by three steps", 90 * time.Second, ErrInvalidMFACode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, mfa := newMFATestService(t)
+			code := mustCode(t, mfa.Secret, time.Now().Add(tt.offset))
+
+			if err := s.verifyMFACode(context.Background(), mfa, code); !errors.Is(err, tt.wantErr) {
+				t.Errorf("verifyMFACode = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyMFACodeReuse(t *testing.T) {
+	ctx := context.Background()
+	s, mfa := newMFATestService(t)
+	now := time.Now()
+
+	current := mustCode(t, mfa.Secret, now)
+	if err := s.verifyMFACode(ctx, mfa, current); err != nil {
+		t.Fatalf("first use: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		code string
+	}{
+		{"same code again", current},
+		{"code of an earlier step", mustCode(t, mfa.Secret, now.Add(-30*time.Second))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := s.verifyMFACode(ctx, mfa, tt.code); !errors.Is(err, ErrInvalidMFACode) {
+				t.Errorf("verifyMFACode = %v, want %v", err, ErrInvalidMFACode)
+			}
+		})
+	}
+}
+
+func TestUseRecoveryCode(t *testing.T) {
+	ctx := context.Background()
+	s, mfa := newMFATestService(t)
+
+	codes, err := s.issueRecoveryCodes(ctx, mfa.UserID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != recoveryCodeCount {
+		t.Fatalf("issued %d codes, want %d", len(codes), recoveryCodeCount)
+	}
+
+	// Шаги выполняются по порядку и зависят от предыдущих
+	steps := []struct {
+		name    string
+		userID  uuid.UUID
+		code    string
+		wantErr error
+	}{
+		{"first use", mfa.UserID, codes[0], nil},
+		{"reuse", mfa.UserID, codes[0], ErrInvalidMFACode},
+		{"typed in upper case with spaces", mfa.UserID, strings.ToUpper(strings.Replace(codes[1], "-", " ", 1)), nil},
+		{"reuse of normalized code", mfa.UserID, strings.ReplaceAll(codes[1], "-", ""), ErrInvalidMFACode},
+		{"code of another user", uuid.New(), codes[2], ErrInvalidMFACode},
+		{"unknown code", mfa.UserID, "aaaa-bbbb", ErrInvalidMFACode},
+		{"unused code still valid", mfa.UserID, codes[2], nil},
+	}
+
+	for _, step := range steps {
+		if err := s.useRecoveryCode(ctx, step.userID, step.code); !errors.Is(err, step.wantErr) {
+			t.Errorf("%s: useRecoveryCode = %v, want %v", step.name, err, step.wantErr)
+		}
+	}
+
+	// Новые коды заменяют старые, в том числе неиспользованные
+	if _, err := s.issueRecoveryCodes(ctx, mfa.UserID); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.useRecoveryCode(ctx, mfa.UserID, codes[3]); !errors.Is(err, ErrInvalidMFACode) {
+		t.Errorf("replaced code: useRecoveryCode = %v, want %v", err, ErrInvalidMFACode)
+	}
+}
+
+func TestMFALoginFailuresSurvivePasswordStep(t *testing.T) {
+	const (
+		email      = "employer@example.com"
+		password   = "correct horse battery staple"
+		maxFailure = 3
+	)
+	keys, err := jwt.LoadKeySet(t.TempDir(), "", "EdDSA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := hashPassword(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service, mfa := newMFATestService(t)
+	users := &memoryUserRepository{users: make(map[uuid.UUID]*models.User)}
+	if err := users.Create(context.Background(), &models.User{
+		ID: mfa.UserID, Email: email, PasswordHash: hash, Role: models.RoleEmployer, IsActive: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	service.userRepo = users
+	service.jwtManager = jwt.NewJWTManager(keys, 1)
+	service.options.MFAChallengeTTL = time.Minute
+	service.options.LoginGuard = lockout.NewGuard(lockout.NewMemoryStore(), &memoryLockoutEventRepository{}, lockout.Config{
+		MaxAccountFailures: maxFailure,
+		MaxIPFailures:      100,
+		Window:             time.Hour,
+		LockDuration:       time.Hour,
+	})
+
+	ctx := context.Background()
+	client := dto.ClientInfo{IPAddress: "203.0.113.10"}
+	wrongCode := mustCode(t, mfa.Secret, time.Now().Add(time.Hour))
+
+	// Знающий пароль перед каждой попыткой кода заново проходит первый шаг
+	for attempt := 1; attempt <= maxFailure; attempt++ {
+		response, err := service.Login(ctx, &dto.LoginRequest{Email: email, Password: password}, client)
+		if err != nil {
+			t.Fatalf("attempt %d: Login error = %v", attempt, err)
+		}
+		if response.MFA == nil {
+			t.Fatalf("attempt %d: Login returned tokens without a second factor", attempt)
+		}
+
+		_, err = service.VerifyMFALogin(ctx, &dto.MFALoginRequest{ChallengeToken: response.MFA.ChallengeToken, Code: wrongCode}, client)
+		if !errors.Is(err, ErrInvalidMFACode) {
+			t.Fatalf("attempt %d: VerifyMFALogin error = %v, want %v", attempt, err, ErrInvalidMFACode)
+		}
+	}
+
+	var locked *lockout.LockedError
+	if _, err := service.Login(ctx, &dto.LoginRequest{Email: email, Password: password}, client); !errors.As(err, &locked) {
+		t.Errorf("Login after %d wrong codes error = %v, want lockout", maxFailure, err)
+	}
+}
@@ -0,0 +1,353 @@
+package service
+
+import (
+	"auth-service/internal/dto"
+	"auth-service/internal/models"
+	"auth-service/internal/oidc"
+	"auth-service/internal/repository"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"log/slog"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Время жизни параметров входа через провайдера и кода его завершения
+const (
+	OIDCStateTTL     = 10 * time.Minute
+	oidcLoginCodeTTL = time.Minute
+)
+
+// Ошибки входа через провайдеров OIDC
+var (
+	ErrOIDCProviderNotFound       = errors.New("провайдер входа не найден")
+	ErrOIDCStateInvalid           = errors.New("вход через провайдера устарел, начните заново")
+	ErrOIDCEmailNotVerified       = errors.New("провайдер не подтвердил email")
+	ErrOIDCRegistrationNotAllowed = errors.New("регистрация через провайдера доступна только для адресов университета")
+	ErrOIDCLinkNotAllowed         = errors.New("учётную запись с этим email нельзя связать с провайдером, войдите по паролю")
+	ErrInvalidOIDCLoginCode       = errors.New("код входа недействителен или устарел")
+)
+
+// Коды ошибок, с которыми веб-приложение получает пользователя обратно
+const (
+	oidcErrorInvalidState   = "invalid_state"
+	oidcErrorEmail          = "email_not_verified"
+	oidcErrorRegistration   = "registration_not_allowed"
+	oidcErrorLink           = "account_exists"
+	oidcErrorInactive       = "account_inactive"
+	oidcErrorPending        = "account_pending_approval"
+	oidcErrorProvider       = "provider_error"
+	oidcErrorAccessDenied   = "access_denied"
+	oidcFrontendCallbackURL = "/oidc/callback"
+)
+
+// ListOIDCProviders возвращает провайдеров, через которых можно войти
+func (s *authService) ListOIDCProviders() []dto.OIDCProviderResponse {
+	response := make([]dto.OIDCProviderResponse, 0, len(s.options.OIDCProviders))
+	for _, provider := range s.options.OIDCProviders {
+		cfg := provider.Config()
+		response = append(response, dto.OIDCProviderResponse{Name: cfg.Name, DisplayName: cfg.DisplayName})
+	}
+	sort.Slice(response, func(i, j int) bool { return response[i].Name < response[j].Name })
+	return response
+}
+
+// StartOIDCLogin начинает вход через провайдера и возвращает адрес его страницы входа
+// и state, который нужно сохранить в браузере пользователя до его возврата
+func (s *authService) StartOIDCLogin(ctx context.Context, providerName string) (string, string, error) {
+	provider, ok := s.options.OIDCProviders[providerName]
+	if !ok {
+		return "", "", ErrOIDCProviderNotFound
+	}
+
+	// state защищает от CSRF, nonce - от подмены ID токена, code_verifier - от перехвата кода (PKCE)
+	var values [3]string
+	for i := range values {
+		value, err := randomToken()
+		if err != nil {
+			return "", "", err
+		}
+		values[i] = value
+	}
+	state, nonce, codeVerifier := values[0], values[1], values[2]
+
+	err := s.externalIdentityRepo.CreateState(ctx, &models.OIDCState{
+		StateHash:    hashToken(state),
+		Provider:     providerName,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    time.Now().Add(OIDCStateTTL),
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	authURL, err := provider.AuthCodeURL(ctx, s.oidcRedirectURI(providerName), state, nonce, codeVerifier)
+	if err != nil {
+		return "", "", err
+	}
+	return authURL, state, nil
+}
+
+// CompleteOIDCLogin обрабатывает возврат пользователя от провайдера и возвращает адрес
+// веб-приложения: с одноразовым кодом входа или с кодом ошибки. Ошибка возвращается
+// вместе с адресом только для журнала.
+func (s *authService) CompleteOIDCLogin(ctx context.Context, providerName string, callback dto.OIDCCallbackRequest) (string, error) {
+	code, err := s.completeOIDCLogin(ctx, providerName, callback)
+	observeLogin(loginMethodOIDC, nil, err)
+	if err != nil {
+		return s.oidcFrontendURL(url.Values{"error": {oidcErrorCode(err)}}), err
+	}
+	return s.oidcFrontendURL(url.Values{"code": {code}}), nil
+}
+
+// ExchangeOIDCLoginCode обменивает одноразовый код входа на токены (или второй шаг входа)
+func (s *authService) ExchangeOIDCLoginCode(ctx context.Context, req *dto.OIDCExchangeRequest, client dto.ClientInfo) (*dto.AuthResponse, error) {
+	stored, err := s.userTokenRepo.FindByHash(ctx, hashToken(req.Code), models.PurposeOIDCLogin)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserTokenNotFound) {
+			return nil, ErrInvalidOIDCLoginCode
+		}
+		return nil, err
+	}
+	if !stored.IsUsable() {
+		return nil, ErrInvalidOIDCLoginCode
+	}
+	if err := s.userTokenRepo.MarkUsed(ctx, stored.ID); err != nil {
+		if errors.Is(err, repository.ErrUserTokenUsed) {
+			return nil, ErrInvalidOIDCLoginCode
+		}
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive {
+		return nil, ErrUserNotActive
+	}
+	if err := checkApproval(user); err != nil {
+		return nil, err
+	}
+
+	// Второй фактор требуется и при входе через провайдера
+	challenge, err := s.mfaChallenge(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if challenge != nil {
+		return &dto.AuthResponse{User: dto.ToUserResponse(user), MFA: challenge}, nil
+	}
+
+	tokens, err := s.startSession(ctx, user, client)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.AuthResponse{User: dto.ToUserResponse(user), Tokens: tokens}, nil
+}
+
+// completeOIDCLogin проверяет ответ провайдера, находит или создаёт пользователя
+// и выдаёт одноразовый код входа для веб-приложения
+func (s *authService) completeOIDCLogin(ctx context.Context, providerName string, callback dto.OIDCCallbackRequest) (string, error) {
+	provider, ok := s.options.OIDCProviders[providerName]
+	if !ok {
+		return "", ErrOIDCProviderNotFound
+	}
+
+	// Пользователь отказался от входа или провайдер вернул ошибку
+	if callback.Error != "" {
+		return "", &oidcProviderError{code: callback.Error}
+	}
+
+	// Возврат принимается только в том браузере, который начал вход: иначе злоумышленник
+	// мог бы подсунуть жертве ссылку возврата со своими code и state и войти ею в свою учётную запись
+	if callback.BrowserState == "" || subtle.ConstantTimeCompare([]byte(callback.BrowserState), []byte(callback.State)) != 1 {
+		return "", ErrOIDCStateInvalid
+	}
+
+	state, err := s.externalIdentityRepo.ConsumeState(ctx, hashToken(callback.State))
+	if err != nil {
+		if errors.Is(err, repository.ErrOIDCStateNotFound) {
+			return "", ErrOIDCStateInvalid
+		}
+		return "", err
+	}
+	if state.Provider != providerName {
+		return "", ErrOIDCStateInvalid
+	}
+
+	claims, err := provider.Exchange(ctx, s.oidcRedirectURI(providerName), callback.Code, state.CodeVerifier, state.Nonce)
+	if err != nil {
+		return "", err
+	}
+
+	user, err := s.userForExternalIdentity(ctx, provider, claims)
+	if err != nil {
+		return "", err
+	}
+	if !user.IsActive {
+		return "", ErrUserNotActive
+	}
+	if err := checkApproval(user); err != nil {
+		return "", err
+	}
+
+	code, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	err = s.userTokenRepo.Create(ctx, &models.UserToken{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		Purpose:   models.PurposeOIDCLogin,
+		TokenHash: hashToken(code),
+		ExpiresAt: time.Now().Add(oidcLoginCodeTTL),
+	})
+	if err != nil {
+		return "", err
+	}
+	return code, nil
+}
+
+// userForExternalIdentity находит пользователя, связанного с учётной записью провайдера.
+// Новая учётная запись провайдера принимается только с адреса доменов студентов провайдера:
+// она связывается с существующим студентом, который сам подтвердил этот email,
+// а при первом входе создаётся студент. Иначе провайдер (или пользователь, заранее
+// зарегистрировавший чужой адрес) мог бы получить доступ к чужой учётной записи.
+func (s *authService) userForExternalIdentity(ctx context.Context, provider *oidc.Provider, claims *oidc.Claims) (*models.User, error) {
+	providerName := provider.Config().Name
+
+	identity, err := s.externalIdentityRepo.FindByProviderSubject(ctx, providerName, claims.Subject)
+	if err == nil {
+		if err := s.externalIdentityRepo.TouchLogin(ctx, identity.ID); err != nil {
+			return nil, err
+		}
+		return s.userRepo.FindByID(ctx, identity.UserID)
+	}
+	if !errors.Is(err, repository.ErrExternalIdentityNotFound) {
+		return nil, err
+	}
+
+	// Связывать по email можно только если провайдер подтвердил владение адресом
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, ErrOIDCEmailNotVerified
+	}
+	if !provider.IsStudentDomain(claims.Email) {
+		return nil, ErrOIDCRegistrationNotAllowed
+	}
+
+	user, err := s.userRepo.FindByEmail(ctx, claims.Email)
+	switch {
+	case err == nil:
+		// Подтверждение email провайдером не переносится на локальную учётную запись:
+		// связывание только со студентом, уже подтвердившим адрес письмом
+		if !canLinkExternalIdentity(user) {
+			return nil, ErrOIDCLinkNotAllowed
+		}
+	case errors.Is(err, repository.ErrUserNotFound):
+		if user, err = s.registerExternalStudent(ctx, provider, claims); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	err = s.externalIdentityRepo.Create(ctx, &models.ExternalIdentity{
+		UserID:      user.ID,
+		Provider:    providerName,
+		Subject:     claims.Subject,
+		Email:       claims.Email,
+		LastLoginAt: time.Now(),
+	})
+	if err != nil && !errors.Is(err, repository.ErrExternalIdentityExists) {
+		return nil, err
+	}
+	return user, nil
+}
+
+// canLinkExternalIdentity проверяет, можно ли связать существующую учётную запись с провайдером
+// без ввода пароля. Провайдер подтверждает только студентов университета, поэтому работодатели,
+// сотрудники и администраторы связываются лишь через вход по паролю.
+func canLinkExternalIdentity(user *models.User) bool {
+	return user.Role == models.RoleStudent && user.EmailVerified
+}
+
+// registerExternalStudent создаёт студента при первом входе через провайдера
+// (адрес уже проверен на принадлежность доменам студентов).
+// Пароль случайный: при необходимости его можно задать через сброс пароля.
+func (s *authService) registerExternalStudent(ctx context.Context, provider *oidc.Provider, claims *oidc.Claims) (*models.User, error) {
+	password, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := hashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	user := &models.User{
+		Email:           claims.Email,
+		PasswordHash:    hashedPassword,
+		Role:            models.RoleStudent,
+		IsActive:        true,
+		EmailVerified:   true,
+		EmailVerifiedAt: &now,
+		ApprovalStatus:  models.ApprovalApproved,
+	}
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "Создан студент при входе через провайдера", "user_id", user.ID, "provider", provider.Config().Name)
+	return user, nil
+}
+
+// oidcRedirectURI возвращает адрес возврата от провайдера (должен быть зарегистрирован у провайдера)
+func (s *authService) oidcRedirectURI(providerName string) string {
+	return s.options.OIDCRedirectBaseURL + "/api/auth/oidc/" + url.PathEscape(providerName) + "/callback"
+}
+
+// oidcFrontendURL возвращает адрес страницы веб-приложения, завершающей вход
+func (s *authService) oidcFrontendURL(query url.Values) string {
+	return s.options.AppURL + oidcFrontendCallbackURL + "?" + query.Encode()
+}
+
+// oidcProviderError - провайдер вернул пользователя с ошибкой (например, access_denied)
+type oidcProviderError struct {
+	code string
+}
+
+// Error реализует интерфейс error
+func (e *oidcProviderError) Error() string {
+	return "провайдер вернул ошибку: " + e.code
+}
+
+// oidcErrorCode переводит ошибку входа в код для веб-приложения
+func oidcErrorCode(err error) string {
+	var providerErr *oidcProviderError
+
+	switch {
+	case errors.As(err, &providerErr) && providerErr.code == oidcErrorAccessDenied:
+		return oidcErrorAccessDenied
+	case errors.Is(err, ErrOIDCStateInvalid):
+		return oidcErrorInvalidState
+	case errors.Is(err, ErrOIDCEmailNotVerified):
+		return oidcErrorEmail
+	case errors.Is(err, ErrOIDCRegistrationNotAllowed):
+		return oidcErrorRegistration
+	case errors.Is(err, ErrOIDCLinkNotAllowed):
+		return oidcErrorLink
+	case errors.Is(err, ErrUserNotActive), errors.Is(err, ErrAccountRejected):
+		return oidcErrorInactive
+	case errors.Is(err, ErrAccountPendingApproval):
+		return oidcErrorPending
+	default:
+		return oidcErrorProvider
+	}
+}
@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"auth-service/internal/dto"
+	"auth-service/internal/models"
+	"auth-service/internal/oidc"
+	"auth-service/internal/oidc/oidctest"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// oidcTestEnv - сервис, подключённый к учебному провайдеру "university" с доменом university.kz
+type oidcTestEnv struct {
+	service    *authService
+	idp        *oidctest.Server
+	users      *memoryUserRepository
+	identities *memoryExternalIdentityRepository
+}
+
+func newOIDCTestEnv(t *testing.T) *oidcTestEnv {
+	t.Helper()
+	idp, err := oidctest.NewServer("student-employment", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(idp.Close)
+
+	newProvider := func(name string) *oidc.Provider {
+		return oidc.NewProvider(oidc.Config{
+			Name:           name,
+			Issuer:         idp.Issuer(),
+			ClientID:       "student-employment",
+			ClientSecret:   "secret",
+			StudentDomains: []string{"university.kz"},
+		})
+	}
+
+	env := &oidcTestEnv{
+		idp:        idp,
+		users:      &memoryUserRepository{users: make(map[uuid.UUID]*models.User)},
+		identities: &memoryExternalIdentityRepository{states: make(map[string]*models.OIDCState)},
+	}
+	env.service = &authService{
+		userRepo:             env.users,
+		externalIdentityRepo: env.identities,
+		userTokenRepo:        &memoryUserTokenRepository{},
+		options: Options{
+			AppURL:              "http://app.test",
+			OIDCRedirectBaseURL: "http://gateway.test",
+			OIDCProviders: map[string]*oidc.Provider{
+				"university": newProvider("university"),
+				"other":      newProvider("other"),
+			},
+		},
+	}
+	return env
+}
+
+// login проходит вход у провайдера от имени email и возвращает параметры возврата
+func (e *oidcTestEnv) login(t *testing.T, email string) dto.OIDCCallbackRequest {
+	t.Helper()
+	authURL, state, err := e.service.StartOIDCLogin(context.Background(), "university")
+	if err != nil {
+		t.Fatal(err)
+	}
+	callback, err := e.idp.Login(authURL, email)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dto.OIDCCallbackRequest{Code: callback.Get("code"), State: callback.Get("state"), BrowserState: state}
+}
+
+// complete завершает вход и проверяет, что адрес возврата соответствует ошибке
+func (e *oidcTestEnv) complete(t *testing.T, provider string, callback dto.OIDCCallbackRequest) error {
+	t.Helper()
+	redirect, err := e.service.CompleteOIDCLogin(context.Background(), provider, callback)
+
+	parsed, parseErr := url.Parse(redirect)
+	if parseErr != nil || !strings.HasPrefix(redirect, "http://app.test/oidc/callback?") {
+		t.Fatalf("redirect = %q", redirect)
+	}
+	switch query := parsed.Query(); {
+	case err == nil && query.Get("code") == "":
+		t.Errorf("redirect without login code: %q", redirect)
+	case err != nil && query.Get("error") != oidcErrorCode(err):
+		t.Errorf("redirect error = %q, want %q", query.Get("error"), oidcErrorCode(err))
+	}
+	return err
+}
+
+func TestCompleteOIDCLoginState(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		tamper   func(t *testing.T, env *oidcTestEnv, callback *dto.OIDCCallbackRequest)
+		wantErr  error
+	}{
+		{name: "valid state", provider: "university"},
+		{
+			name: "forged state", provider: "university",
+			tamper:  func(_ *testing.T, _ *oidcTestEnv, callback *dto.OIDCCallbackRequest) { callback.State = "forged" },
+			wantErr: ErrOIDCStateInvalid,
+		},
+		{
+			name: "missing state", provider: "university",
+			tamper:  func(_ *testing.T, _ *oidcTestEnv, callback *dto.OIDCCallbackRequest) { callback.State = "" },
+			wantErr: ErrOIDCStateInvalid,
+		},
+		{
+			name: "callback in another browser", provider: "university",
+			tamper:  func(_ *testing.T, _ *oidcTestEnv, callback *dto.OIDCCallbackRequest) { callback.BrowserState = "" },
+			wantErr: ErrOIDCStateInvalid,
+		},
+		{
+			name: "state of another browser", provider: "university",
+			tamper: func(t *testing.T, env *oidcTestEnv, callback *dto.OIDCCallbackRequest) {
+				// Злоумышленник начал свой вход, а жертве подсунул его возврат
+				other := env.login(t, "attacker@university.kz")
+				callback.Code, callback.State = other.Code, other.State
+			},
+			wantErr: ErrOIDCStateInvalid,
+		},
+		{
+			name: "expired state", provider: "university",
+			tamper: func(_ *testing.T, env *oidcTestEnv, _ *dto.OIDCCallbackRequest) {
+				for _, state := range env.identities.states {
+					state.ExpiresAt = time.Now().Add(-time.Second)
+				}
+			},
+			wantErr: ErrOIDCStateInvalid,
+		},
+		{name: "state of another provider", provider: "other", wantErr: ErrOIDCStateInvalid},
+		{
+			name: "nonce of another login", provider: "university",
+			tamper: func(_ *testing.T, env *oidcTestEnv, _ *dto.OIDCCallbackRequest) {
+				env.idp.SetClaims(func(c jwt.MapClaims) { c["nonce"] = "other-nonce" })
+			},
+			wantErr: oidc.ErrInvalidIDToken,
+		},
+		{
+			name: "code verifier of another login", provider: "university",
+			tamper: func(_ *testing.T, env *oidcTestEnv, _ *dto.OIDCCallbackRequest) {
+				for _, state := range env.identities.states {
+					state.CodeVerifier = "other-verifier"
+				}
+			},
+			wantErr: oidc.ErrCodeExchange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newOIDCTestEnv(t)
+			callback := env.login(t, "student@university.kz")
+			if tt.tamper != nil {
+				tt.tamper(t, env, &callback)
+			}
+
+			if err := env.complete(t, tt.provider, callback); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CompleteOIDCLogin error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCompleteOIDCLoginStateIsSingleUse(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	callback := env.login(t, "student@university.kz")
+	if err := env.complete(t, "university", callback); err != nil {
+		t.Fatalf("first callback: %v", err)
+	}
+
+	// Повтор того же возврата (например, из истории браузера)
+	if err := env.complete(t, "university", callback); !errors.Is(err, ErrOIDCStateInvalid) {
+		t.Errorf("replayed callback error = %v, want %v", err, ErrOIDCStateInvalid)
+	}
+}
+
+func TestCompleteOIDCLoginProviderError(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	redirect, err := env.service.CompleteOIDCLogin(context.Background(), "university", dto.OIDCCallbackRequest{Error: "access_denied"})
+	if err == nil || !strings.HasSuffix(redirect, "?error="+oidcErrorAccessDenied) {
+		t.Errorf("redirect = %q, err = %v", redirect, err)
+	}
+}
+
+func TestOIDCLinkingRules(t *testing.T) {
+	tests := []struct {
+		name           string
+		existing       *models.User        // Локальная учётная запись с тем же email
+		email          string              // Email у провайдера
+		claims         func(jwt.MapClaims) // Изменение ID токена провайдером
+		wantErr        error
+		wantLinked     bool // Связь создана с существующей учётной записью
+		wantRegistered bool // Создан новый студент
+	}{
+		{
+			name:     "verified student is linked",
+			existing: &models.User{Role: models.RoleStudent, EmailVerified: true, IsActive: true, ApprovalStatus: models.ApprovalApproved},
+			email:    "student@university.kz", wantLinked: true,
+		},
+		{
+			name:     "unverified student is not linked",
+			existing: &models.User{Role: models.RoleStudent, IsActive: true, ApprovalStatus: models.ApprovalApproved},
+			email:    "student@university.kz", wantErr: ErrOIDCLinkNotAllowed,
+		},
+		{
+			name:     "employer is not linked",
+			existing: &models.User{Role: models.RoleEmployer, EmailVerified: true, IsActive: true, ApprovalStatus: models.ApprovalApproved},
+			email:    "student@university.kz", wantErr: ErrOIDCLinkNotAllowed,
+		},
+		{
+			name:     "admin is not linked",
+			existing: &models.User{Role: models.RoleAdmin, EmailVerified: true, IsActive: true, ApprovalStatus: models.ApprovalApproved},
+			email:    "student@university.kz", wantErr: ErrOIDCLinkNotAllowed,
+		},
+		{
+			name:     "university staff is not linked",
+			existing: &models.User{Role: models.RoleUniversity, EmailVerified: true, IsActive: true, ApprovalStatus: models.ApprovalApproved},
+			email:    "student@university.kz", wantErr: ErrOIDCLinkNotAllowed,
+		},
+		{
+			name:     "verified student outside provider domains is not linked",
+			existing: &models.User{Role: models.RoleStudent, EmailVerified: true, IsActive: true, ApprovalStatus: models.ApprovalApproved},
+			email:    "student@gmail.com", wantErr: ErrOIDCRegistrationNotAllowed,
+		},
+		{
+			name:     "deactivated student is linked but cannot log in",
+			existing: &models.User{Role: models.RoleStudent, EmailVerified: true, ApprovalStatus: models.ApprovalApproved},
+			email:    "student@university.kz", wantErr: ErrUserNotActive, wantLinked: true,
+		},
+		{name: "new student in provider domain is registered", email: "student@university.kz", wantRegistered: true},
+		{name: "new student in subdomain is registered", email: "student@mail.university.kz", wantRegistered: true},
+		{name: "new user outside provider domains", email: "student@gmail.com", wantErr: ErrOIDCRegistrationNotAllowed},
+		{
+			name: "email not verified by provider", email: "student@university.kz",
+			claims:  func(c jwt.MapClaims) { c["email_verified"] = false },
+			wantErr: ErrOIDCEmailNotVerified,
+		},
+		{
+			name:     "email not verified by provider is not linked",
+			existing: &models.User{Role: models.RoleStudent, EmailVerified: true, IsActive: true, ApprovalStatus: models.ApprovalApproved},
+			email:    "student@university.kz",
+			claims:   func(c jwt.MapClaims) { c["email_verified"] = false },
+			wantErr:  ErrOIDCEmailNotVerified,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := newOIDCTestEnv(t)
+			env.idp.SetClaims(tt.claims)
+			if tt.existing != nil {
+				tt.existing.Email = tt.email
+				if err := env.users.Create(context.Background(), tt.existing); err != nil {
+					t.Fatal(err)
+				}
+			}
+			wasVerified := tt.existing != nil && tt.existing.EmailVerified
+
+			err := env.complete(t, "university", env.login(t, tt.email))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CompleteOIDCLogin error = %v, want %v", err, tt.wantErr)
+			}
+
+			linked := len(env.identities.identities) == 1 && tt.existing != nil &&
+				env.identities.identities[0].UserID == tt.existing.ID
+			if linked != tt.wantLinked {
+				t.Errorf("linked = %v, want %v (identities %+v)", linked, tt.wantLinked, env.identities.identities)
+			}
+
+			var registered *models.User
+			for _, user := range env.users.users {
+				if tt.existing == nil || user.ID != tt.existing.ID {
+					registered = user
+				}
+			}
+			if (registered != nil) != tt.wantRegistered {
+				t.Fatalf("registered = %+v, want %v", registered, tt.wantRegistered)
+			}
+			if registered != nil && (registered.Role != models.RoleStudent || !registered.EmailVerified) {
+				t.Errorf("registered user = %+v, want verified student", registered)
+			}
+			if !tt.wantLinked && !tt.wantRegistered && len(env.identities.identities) != 0 {
+				t.Errorf("identities = %+v, want none", env.identities.identities)
+			}
+
+			// Подтверждение провайдера не переносится на локальную учётную запись
+			if tt.existing != nil && tt.existing.EmailVerified != wasVerified {
+				t.Errorf("EmailVerified changed to %v", tt.existing.EmailVerified)
+			}
+		})
+	}
+}
+
+func TestOIDCLinkedIdentityLogin(t *testing.T) {
+	env := newOIDCTestEnv(t)
+	if err := env.complete(t, "university", env.login(t, "student@university.kz")); err != nil {
+		t.Fatalf("first login: %v", err)
+	}
+	if len(env.users.users) != 1 || len(env.identities.identities) != 1 {
+		t.Fatalf("users = %d, identities = %d, want 1 and 1", len(env.users.users), len(env.identities.identities))
+	}
+	userID := env.identities.identities[0].UserID
+
+	// Повторный вход находит связь по subject, даже если email у провайдера сменился
+	env.idp.SetClaims(func(c jwt.MapClaims) { c["email"] = "renamed@gmail.com" })
+	if err := env.complete(t, "university", env.login(t, "student@university.kz")); err != nil {
+		t.Fatalf("second login: %v", err)
+	}
+	if len(env.users.users) != 1 || len(env.identities.identities) != 1 {
+		t.Errorf("users = %d, identities = %d, want 1 and 1", len(env.users.users), len(env.identities.identities))
+	}
+
+	// Деактивированный пользователь не входит и через связанного провайдера
+	env.users.users[userID].IsActive = false
+	if err := env.complete(t, "university", env.login(t, "student@university.kz")); !errors.Is(err, ErrUserNotActive) {
+		t.Errorf("deactivated login error = %v, want %v", err, ErrUserNotActive)
+	}
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"auth-service/internal/dto"
+	"auth-service/internal/mail"
+	"auth-service/internal/models"
+	"auth-service/internal/repository"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// passwordResetInterval - минимальный интервал между письмами сброса пароля
+const passwordResetInterval = time.Minute
+
+// ForgotPassword отправляет ссылку для сброса пароля.
+// Результат и время ответа не зависят от того, существует ли пользователь, чтобы нельзя было
+// перебирать адреса: письмо отправляется в фоне, ошибки отправки только логируются.
+func (s *authService) ForgotPassword(ctx context.Context, req *dto.ForgotPasswordRequest) error {
+	user, err := s.userRepo.FindByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	// Деактивированной учётной записи сброс пароля не поможет
+	if !user.IsActive {
+		return nil
+	}
+
+	// Ограничение частоты отправки писем
+	latest, err := s.userTokenRepo.FindLatest(ctx, user.ID, models.PurposePasswordReset)
+	if err != nil && !errors.Is(err, repository.ErrUserTokenNotFound) {
+		return err
+	}
+	if latest != nil && time.Since(latest.CreatedAt) < passwordResetInterval {
+		return nil
+	}
+
+	// Письмо отправляется после ответа: контекст запроса к этому времени отменён,
+	// но идентификаторы запроса и трассировки в нём сохраняются
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		if err := s.sendPasswordResetEmail(ctx, user); err != nil {
+			slog.ErrorContext(ctx, "Ошибка отправки письма сброса пароля", "user_id", user.ID, "error", err)
+		}
+	}()
+	return nil
+}
+
+// ResetPassword устанавливает новый пароль по одноразовому токену из письма
+// и завершает все сеансы пользователя
+func (s *authService) ResetPassword(ctx context.Context, req *dto.ResetPasswordRequest) error {
+	stored, err := s.userTokenRepo.FindByHash(ctx, hashToken(req.Token), models.PurposePasswordReset)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserTokenNotFound) {
+			return ErrInvalidResetToken
+		}
+		return err
+	}
+	if !stored.IsUsable() {
+		return ErrInvalidResetToken
+	}
+
+	user, err := s.userRepo.FindByID(ctx, stored.UserID)
+	if err != nil {
+		return err
+	}
+	if !user.IsActive {
+		return ErrUserNotActive
+	}
+
+	// Проверка нового пароля до использования токена, чтобы ссылку можно было повторить
+	if err := s.validatePassword("new_password", req.NewPassword, user.Email); err != nil {
+		return err
+	}
+
+	// Атомарная отметка об использовании
+	if err := s.userTokenRepo.MarkUsed(ctx, stored.ID); err != nil {
+		if errors.Is(err, repository.ErrUserTokenUsed) {
+			return ErrInvalidResetToken
+		}
+		return err
+	}
+
+	hashedPassword, err := hashPassword(req.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	// Переход по ссылке из письма подтверждает владение адресом
+	user.PasswordHash = hashedPassword
+	if !user.EmailVerified {
+		now := time.Now()
+		user.EmailVerified = true
+		user.EmailVerifiedAt = &now
+	}
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	// Остальные ссылки сброса больше не нужны
+	if err := s.userTokenRepo.InvalidateAll(ctx, user.ID, models.PurposePasswordReset); err != nil {
+		return err
+	}
+
+	// Все сеансы, открытые со старым паролем, завершаются
+	return s.sessionRepo.RevokeAllForUser(ctx, user.ID, uuid.Nil)
+}
+
+// ChangePassword меняет пароль после проверки текущего.
+// Текущий сеанс сохраняется, остальные сеансы пользователя завершаются.
+func (s *authService) ChangePassword(ctx context.Context, userID, sessionID uuid.UUID, req *dto.ChangePasswordRequest) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// Проверка текущего пароля
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.CurrentPassword)); err != nil {
+		return ErrWrongPassword
+	}
+	if req.NewPassword == req.CurrentPassword {
+		return ErrSamePassword
+	}
+	if err := s.validatePassword("new_password", req.NewPassword, user.Email); err != nil {
+		return err
+	}
+
+	hashedPassword, err := hashPassword(req.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	user.PasswordHash = hashedPassword
+	if err := s.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	// Ранее запрошенные ссылки сброса пароля больше не действуют
+	if err := s.userTokenRepo.InvalidateAll(ctx, user.ID, models.PurposePasswordReset); err != nil {
+		return err
+	}
+
+	// Для токена без сеанса (uuid.Nil) завершаются все сеансы
+	return s.sessionRepo.RevokeAllForUser(ctx, user.ID, sessionID)
+}
+
+// validatePassword проверяет пароль по политике и возвращает ValidationError
+// с отдельной ошибкой на каждое нарушенное правило (ключ "<поле>.<правило>")
+func (s *authService) validatePassword(field, value, email string) error {
+	violations, err := s.options.PasswordPolicy.Validate(value, email)
+	if err != nil {
+		return err
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	details := make(map[string]string, len(violations))
+	for _, violation := range violations {
+		details[field+"."+violation.Rule] = violation.Message
+	}
+	return &ValidationError{Details: details}
+}
+
+// hashPassword хеширует пароль с использованием bcrypt
+func hashPassword(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashed), nil
+}
+
+// mustHashPassword хеширует пароль при инициализации пакета
+func mustHashPassword(password string) string {
+	hashed, err := hashPassword(password)
+	if err != nil {
+		panic(err)
+	}
+	return hashed
+}
+
+// sendPasswordResetEmail выпускает случайный токен сброса и отправляет ссылку на email пользователя
+func (s *authService) sendPasswordResetEmail(ctx context.Context, user *models.User) error {
+	token, err := randomToken()
+	if err != nil {
+		return err
+	}
+	ttl := s.options.PasswordResetTTL
+
+	err = s.userTokenRepo.Create(ctx, &models.UserToken{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		Purpose:   models.PurposePasswordReset,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(ttl),
+	})
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/reset-password?token=%s", s.options.AppURL, url.QueryEscape(token))
+
+	return s.mailSender.Send(mail.Message{
+		To:      user.Email,
+		Subject: "Сброс пароля",
+		Body: fmt.Sprintf(
+			"Здравствуйте!\n\nДля установки нового пароля перейдите по ссылке:\n%s\n\n"+
+				"Ссылка действительна %d мин. Если вы не запрашивали сброс пароля, проигнорируйте это письмо.\n",
+			link, int(ttl.Minutes()),
+		),
+	})
+}
+
+// randomToken генерирует случайный токен (256 бит) в base64url-представлении
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"auth-service/internal/dto"
+	"auth-service/internal/models"
+	"auth-service/internal/repository"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// GetRoleProfile возвращает данные пользователя вместе с ролевым профилем
+func (s *authService) GetRoleProfile(ctx context.Context, userID uuid.UUID) (*dto.ProfileResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.findRoleProfile(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.ToProfileResponse(user, profile)
+	return &response, nil
+}
+
+// UpdateProfile создаёт или обновляет профиль в соответствии с ролью пользователя.
+// Профиль студента заполняется в student-service (PUT /api/students/me).
+func (s *authService) UpdateProfile(ctx context.Context, userID uuid.UUID, req *dto.UpdateProfileRequest) (*dto.ProfileResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Проверка активности учётной записи
+	if !user.IsActive {
+		return nil, ErrUserNotActive
+	}
+
+	var profile interface{}
+	switch user.Role {
+	case models.RoleEmployer:
+		profile, err = s.saveEmployerProfile(ctx, user.ID, req)
+	case models.RoleUniversity:
+		profile, err = s.saveUniversityProfile(ctx, user.ID, req)
+	default:
+		return nil, ErrProfileNotSupported
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.ToProfileResponse(user, profile)
+	return &response, nil
+}
+
+// findRoleProfile загружает профиль пользователя; отсутствие профиля не является ошибкой
+func (s *authService) findRoleProfile(ctx context.Context, user *models.User) (interface{}, error) {
+	var (
+		profile interface{}
+		err     error
+	)
+
+	switch user.Role {
+	case models.RoleEmployer:
+		profile, err = s.profileRepo.FindEmployerProfile(ctx, user.ID)
+	case models.RoleUniversity:
+		profile, err = s.profileRepo.FindUniversityProfile(ctx, user.ID)
+	default:
+		return nil, nil
+	}
+
+	if errors.Is(err, repository.ErrProfileNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// saveEmployerProfile валидирует и сохраняет профиль работодателя
+func (s *authService) saveEmployerProfile(ctx context.Context, userID uuid.UUID, req *dto.UpdateProfileRequest) (*models.EmployerProfile, error) {
+	contactPhone, err := validateEmployerProfile(req)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.profileRepo.FindEmployerProfile(ctx, userID)
+	if errors.Is(err, repository.ErrProfileNotFound) {
+		profile, err = &models.EmployerProfile{UserID: userID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	profile.BIN = req.BIN
+	profile.CompanyName = strings.TrimSpace(req.CompanyName)
+	profile.CompanyEmail = req.CompanyEmail
+	profile.ContactPhone = contactPhone
+
+	if err := s.profileRepo.SaveEmployerProfile(ctx, profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// saveUniversityProfile валидирует и сохраняет профиль университета
+func (s *authService) saveUniversityProfile(ctx context.Context, userID uuid.UUID, req *dto.UpdateProfileRequest) (*models.UniversityProfile, error) {
+	contactPhone, err := validateUniversityProfile(req)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.profileRepo.FindUniversityProfile(ctx, userID)
+	if errors.Is(err, repository.ErrProfileNotFound) {
+		profile, err = &models.UniversityProfile{UserID: userID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	profile.UniversityName = strings.TrimSpace(req.UniversityName)
+	profile.UniversityEmail = req.UniversityEmail
+	profile.ContactPhone = contactPhone
+
+	if err := s.profileRepo.SaveUniversityProfile(ctx, profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
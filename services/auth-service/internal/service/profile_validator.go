@@ -0,0 +1,99 @@
+package service
+
+import (
+	"auth-service/internal/dto"
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+var (
+	// identifierPattern - БИН состоит ровно из 12 цифр
+	identifierPattern = regexp.MustCompile(`^[0-9]{12}$`)
+	// phonePattern - номер телефона в международном формате после удаления разделителей
+	phonePattern = regexp.MustCompile(`^\+?[0-9]{10,15}$`)
+	// phoneSeparators - символы, допустимые при вводе номера телефона
+	phoneSeparators = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "")
+)
+
+// ValidationError содержит ошибки валидации по отдельным полям (или правилам политики паролей)
+type ValidationError struct {
+	Details map[string]string
+}
+
+// Error реализует интерфейс error
+func (e *ValidationError) Error() string {
+	return "ошибка валидации"
+}
+
+// profileValidator накапливает ошибки валидации полей профиля
+type profileValidator struct {
+	details map[string]string
+}
+
+// newProfileValidator создаёт новый валидатор профиля
+func newProfileValidator() *profileValidator {
+	return &profileValidator{details: make(map[string]string)}
+}
+
+// required проверяет, что поле заполнено
+func (v *profileValidator) required(field, value string) bool {
+	if strings.TrimSpace(value) == "" {
+		v.details[field] = "Поле обязательно для заполнения"
+		return false
+	}
+	return true
+}
+
+// identifier проверяет БИН
+func (v *profileValidator) identifier(field, value string) {
+	if v.required(field, value) && !identifierPattern.MatchString(value) {
+		v.details[field] = "Должен состоять из 12 цифр"
+	}
+}
+
+// phone проверяет номер телефона и возвращает его нормализованное значение
+func (v *profileValidator) phone(field, value string) string {
+	normalized := phoneSeparators.Replace(strings.TrimSpace(value))
+	if v.required(field, value) && !phonePattern.MatchString(normalized) {
+		v.details[field] = "Некорректный номер телефона"
+	}
+	return normalized
+}
+
+// email проверяет адрес электронной почты
+func (v *profileValidator) email(field, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	if addr, err := mail.ParseAddress(value); err != nil || addr.Address != value {
+		v.details[field] = "Некорректный email"
+	}
+}
+
+// err возвращает ValidationError, если были найдены ошибки
+func (v *profileValidator) err() error {
+	if len(v.details) == 0 {
+		return nil
+	}
+	return &ValidationError{Details: v.details}
+}
+
+// validateEmployerProfile проверяет поля профиля работодателя
+func validateEmployerProfile(req *dto.UpdateProfileRequest) (contactPhone string, err error) {
+	v := newProfileValidator()
+	v.identifier("bin", req.BIN)
+	v.required("companyName", req.CompanyName)
+	v.email("companyEmail", req.CompanyEmail)
+	contactPhone = v.phone("contactPhone", req.ContactPhone)
+	return contactPhone, v.err()
+}
+
+// validateUniversityProfile проверяет поля профиля университета
+func validateUniversityProfile(req *dto.UpdateProfileRequest) (contactPhone string, err error) {
+	v := newProfileValidator()
+	v.required("universityName", req.UniversityName)
+	v.email("universityEmail", req.UniversityEmail)
+	contactPhone = v.phone("contactPhone", req.ContactPhone)
+	return contactPhone, v.err()
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"auth-service/internal/dto"
+	"auth-service/internal/mail"
+	"auth-service/internal/models"
+	"auth-service/internal/repository"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Ошибки политики регистрации
+var (
+	ErrRoleNotSelfRegistrable = errors.New("эта роль недоступна для самостоятельной регистрации")
+	ErrInvalidInvitation      = errors.New("приглашение недействительно или устарело")
+	ErrAccountPendingApproval = errors.New("учётная запись ожидает одобрения администратором")
+	ErrAccountRejected        = errors.New("регистрация отклонена администратором")
+	ErrNotPendingApproval     = errors.New("учётная запись не ожидает одобрения")
+)
+
+// registrationRole определяет роль и состояние одобрения новой учётной записи по политике регистрации:
+// по приглашению - роль из приглашения без одобрения, самостоятельно - студент и работодатель сразу,
+// университет после одобрения администратором, администратор - никогда.
+func (s *authService) registrationRole(ctx context.Context, req *dto.RegisterRequest) (models.UserRole, models.ApprovalStatus, *models.Invitation, error) {
+	if req.InvitationToken == "" {
+		if !req.Role.IsValid() {
+			return "", "", nil, ErrInvalidRole
+		}
+		if !req.Role.IsSelfRegistrable() {
+			return "", "", nil, ErrRoleNotSelfRegistrable
+		}
+		if req.Role == models.RoleUniversity {
+			return req.Role, models.ApprovalPending, nil, nil
+		}
+		return req.Role, models.ApprovalApproved, nil, nil
+	}
+
+	invitation, err := s.invitationRepo.FindByHash(ctx, hashToken(req.InvitationToken))
+	if err != nil {
+		if errors.Is(err, repository.ErrInvitationNotFound) {
+			return "", "", nil, ErrInvalidInvitation
+		}
+		return "", "", nil, err
+	}
+	if !invitation.IsUsable() {
+		return "", "", nil, ErrInvalidInvitation
+	}
+
+	// Приглашение действует только для адреса, на который оно отправлено
+	details := map[string]string{}
+	if !strings.EqualFold(req.Email, invitation.Email) {
+		details["email"] = "Email не совпадает с адресом приглашения"
+	}
+	if req.Role != "" && req.Role != invitation.Role {
+		details["role"] = "Роль не совпадает с ролью в приглашении"
+	}
+	if len(details) > 0 {
+		return "", "", nil, &ValidationError{Details: details}
+	}
+
+	return invitation.Role, models.ApprovalApproved, invitation, nil
+}
+
+// checkApproval проверяет, что учётная запись одобрена администратором
+func checkApproval(user *models.User) error {
+	switch user.ApprovalStatus {
+	case models.ApprovalPending:
+		return ErrAccountPendingApproval
+	case models.ApprovalRejected:
+		return ErrAccountRejected
+	}
+	return nil
+}
+
+// CreateInvitation отправляет приглашение зарегистрироваться с указанной ролью
+func (s *authService) CreateInvitation(ctx context.Context, actorID uuid.UUID, req *dto.InvitationRequest) (*dto.InvitationResponse, error) {
+	if !req.Role.IsValid() {
+		return nil, ErrInvalidRole
+	}
+
+	exists, err := s.userRepo.ExistsByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, repository.ErrUserAlreadyExists
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	ttl := s.options.InvitationTTL
+
+	invitation := &models.Invitation{
+		ID:        uuid.New(),
+		Email:     req.Email,
+		Role:      req.Role,
+		TokenHash: hashToken(token),
+		CreatedBy: actorID,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	if err := s.invitationRepo.Create(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{"invitation": {token}, "email": {req.Email}}
+	link := fmt.Sprintf("%s/register?%s", s.options.AppURL, query.Encode())
+
+	err = s.mailSender.Send(mail.Message{
+		To:      req.Email,
+		Subject: "Приглашение в систему трудоустройства студентов",
+		Body: fmt.Sprintf(
+			"Здравствуйте!\n\nВас пригласили зарегистрироваться с ролью %s. Для регистрации перейдите по ссылке:\n%s\n\n"+
+				"Ссылка действительна %d ч.\n",
+			req.Role, link, int(ttl.Hours()),
+		),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.InvitationResponse{
+		ID:        invitation.ID,
+		Email:     invitation.Email,
+		Role:      invitation.Role,
+		ExpiresAt: invitation.ExpiresAt,
+		CreatedAt: invitation.CreatedAt,
+	}, nil
+}
+
+// ApproveUser одобряет учётную запись, ожидающую проверки, и уведомляет пользователя
+func (s *authService) ApproveUser(ctx context.Context, actorID, userID uuid.UUID, req *dto.AdminActionRequest) (*dto.UserResponse, error) {
+	return s.reviewUser(ctx, actorID, userID, models.ApprovalApproved, req)
+}
+
+// RejectUser отклоняет учётную запись, ожидающую проверки, и уведомляет пользователя
+func (s *authService) RejectUser(ctx context.Context, actorID, userID uuid.UUID, req *dto.AdminActionRequest) (*dto.UserResponse, error) {
+	return s.reviewUser(ctx, actorID, userID, models.ApprovalRejected, req)
+}
+
+// reviewUser сохраняет решение администратора по учётной записи, ожидающей проверки
+func (s *authService) reviewUser(ctx context.Context, actorID, userID uuid.UUID, status models.ApprovalStatus, req *dto.AdminActionRequest) (*dto.UserResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.ApprovalStatus != models.ApprovalPending {
+		return nil, ErrNotPendingApproval
+	}
+
+	action := models.AdminActionApprove
+	if status == models.ApprovalRejected {
+		action = models.AdminActionReject
+	}
+	user.ApprovalStatus = status
+	err = s.transactor.InTransaction(ctx, func(ctx context.Context) error {
+		if err := s.userRepo.Update(ctx, user); err != nil {
+			return err
+		}
+		return s.recordAdminAction(ctx, actorID, user.ID, action, nil, req.Reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Решение уже сохранено: ошибка отправки уведомления только логируется
+	if err := s.sendApprovalEmail(user, req.Reason); err != nil {
+		slog.ErrorContext(ctx, "Ошибка отправки уведомления о проверке учётной записи", "user_id", user.ID, "error", err)
+	}
+
+	response := dto.ToUserResponse(user)
+	return &response, nil
+}
+
+// BootstrapAdmin создаёт учётную запись администратора из командной строки
+// (первый администратор; остальных приглашают существующие администраторы)
+func (s *authService) BootstrapAdmin(ctx context.Context, email, password string) (*dto.UserResponse, error) {
+	if err := s.validatePassword("password", password, email); err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := hashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	user := &models.User{
+		Email:           email,
+		PasswordHash:    hashedPassword,
+		Role:            models.RoleAdmin,
+		IsActive:        true,
+		EmailVerified:   true,
+		EmailVerifiedAt: &now,
+		ApprovalStatus:  models.ApprovalApproved,
+	}
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+
+	response := dto.ToUserResponse(user)
+	return &response, nil
+}
+
+// sendApprovalEmail сообщает пользователю о решении администратора
+func (s *authService) sendApprovalEmail(user *models.User, reason string) error {
+	var body string
+	if user.ApprovalStatus == models.ApprovalApproved {
+		body = fmt.Sprintf(
+			"Здравствуйте!\n\nВаша учётная запись одобрена. Теперь вы можете войти:\n%s/login\n",
+			s.options.AppURL,
+		)
+	} else {
+		body = "Здравствуйте!\n\nК сожалению, ваша регистрация отклонена администратором.\n"
+		if reason != "" {
+			body += "Причина: " + reason + "\n"
+		}
+	}
+
+	return s.mailSender.Send(mail.Message{
+		To:      user.Email,
+		Subject: "Проверка учётной записи",
+		Body:    body,
+	})
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"auth-service/internal/dto"
+	"auth-service/internal/repository"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Logout завершает текущий сеанс пользователя
+func (s *authService) Logout(ctx context.Context, userID, sessionID uuid.UUID) error {
+	return s.RevokeSession(ctx, userID, sessionID)
+}
+
+// ListSessions возвращает действующие сеансы пользователя с отметкой текущего
+func (s *authService) ListSessions(ctx context.Context, userID, currentSessionID uuid.UUID) ([]dto.SessionResponse, error) {
+	sessions, err := s.sessionRepo.ListActiveByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.SessionResponse, 0, len(sessions))
+	for i := range sessions {
+		response = append(response, dto.ToSessionResponse(&sessions[i], currentSessionID))
+	}
+	return response, nil
+}
+
+// RevokeSession завершает сеанс пользователя по ID
+func (s *authService) RevokeSession(ctx context.Context, userID, sessionID uuid.UUID) error {
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	// Чужой сеанс для пользователя не существует
+	if session.UserID != userID {
+		return repository.ErrSessionNotFound
+	}
+
+	return s.sessionRepo.Revoke(ctx, session.ID)
+}
+
+// RevokeAllSessions завершает все сеансы пользователя на всех устройствах
+func (s *authService) RevokeAllSessions(ctx context.Context, userID uuid.UUID) error {
+	return s.sessionRepo.RevokeAllForUser(ctx, userID, uuid.Nil)
+}
+
+// GetTokenStatus проверяет, действителен ли ещё access токен пользователя:
+// учётная запись должна быть активна, а сеанс токена - не завершён.
+// Access токены выдаются только в рамках сеанса, поэтому токен без сеанса (sessionID = uuid.Nil)
+// недействителен: его нельзя отозвать выходом из системы.
+func (s *authService) GetTokenStatus(ctx context.Context, userID, sessionID uuid.UUID) (*dto.TokenStatusResponse, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return &dto.TokenStatusResponse{Active: false, Reason: dto.TokenStatusUserNotFound}, nil
+		}
+		return nil, err
+	}
+	if !user.IsActive {
+		return &dto.TokenStatusResponse{Active: false, Reason: dto.TokenStatusUserInactive}, nil
+	}
+
+	if sessionID == uuid.Nil {
+		return &dto.TokenStatusResponse{Active: false, Reason: dto.TokenStatusNoSession}, nil
+	}
+	session, err := s.sessionRepo.FindByID(ctx, sessionID)
+	if err != nil && !errors.Is(err, repository.ErrSessionNotFound) {
+		return nil, err
+	}
+	if session == nil || session.UserID != userID || !session.IsActive() {
+		return &dto.TokenStatusResponse{Active: false, Reason: dto.TokenStatusSessionRevoked}, nil
+	}
+
+	return &dto.TokenStatusResponse{Active: true}, nil
+}
+
+// describeDevice формирует краткое описание устройства по заголовку User-Agent
+func describeDevice(userAgent string) string {
+	browser := firstMatch(userAgent, [][2]string{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"YaBrowser/", "Яндекс Браузер"},
+		{"Firefox/", "Firefox"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+	})
+	os := firstMatch(userAgent, [][2]string{
+		{"Android", "Android"},
+		{"iPhone", "iOS"},
+		{"iPad", "iPadOS"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"Linux", "Linux"},
+	})
+
+	switch {
+	case browser != "" && os != "":
+		return browser + ", " + os
+	case browser != "":
+		return browser
+	case os != "":
+		return os
+	}
+	return "Неизвестное устройство"
+}
+
+// firstMatch возвращает название для первого найденного в строке маркера
+func firstMatch(value string, markers [][2]string) string {
+	for _, marker := range markers {
+		if strings.Contains(value, marker[0]) {
+			return marker[1]
+		}
+	}
+	return ""
+}
+
+// truncate обрезает строку до указанной длины в байтах, не разрывая символы UTF-8
+func truncate(value string, maxLen int) string {
+	if len(value) <= maxLen {
+		return value
+	}
+	return strings.ToValidUTF8(value[:maxLen], "")
+}
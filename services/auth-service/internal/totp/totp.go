@@ -0,0 +1,98 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Параметры одноразовых паролей (RFC 6238) — значения по умолчанию,
+// которые поддерживают все распространённые приложения-аутентификаторы
+const (
+	secretSize = 20               // Размер секрета в байтах (160 бит, как у HMAC-SHA1)
+	digits     = 6                // Количество цифр в коде
+	period     = 30 * time.Second // Шаг времени
+	skewSteps  = 1                // Допустимое расхождение часов в шагах в обе стороны
+)
+
+// encoding - base32 без выравнивания, как принято в otpauth URI
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret создаёт новый случайный секрет в base32
+func GenerateSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(secret), nil
+}
+
+// URI формирует otpauth:// ссылку для добавления учётной записи в приложение-аутентификатор (QR-код)
+func URI(issuer, account, secret string) string {
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(digits))
+	query.Set("period", fmt.Sprint(int(period.Seconds())))
+
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// Code вычисляет код для момента now - тот же, что показывает приложение-аутентификатор
+func Code(secret string, now time.Time) (string, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", err
+	}
+	return generate(key, now.Unix()/int64(period.Seconds())), nil
+}
+
+// Validate проверяет код в окне ±skewSteps шагов от момента now.
+// Возвращает номер шага, которому соответствует код: сервис хранит последний
+// использованный шаг, чтобы один и тот же код нельзя было предъявить повторно.
+func Validate(secret, code string, now time.Time) (step int64, ok bool) {
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+
+	code = strings.ReplaceAll(code, " ", "")
+	if len(code) != digits {
+		return 0, false
+	}
+
+	current := now.Unix() / int64(period.Seconds())
+	for offset := int64(-skewSteps); offset <= skewSteps; offset++ {
+		candidate := current + offset
+		if subtle.ConstantTimeCompare([]byte(generate(key, candidate)), []byte(code)) == 1 {
+			return candidate, true
+		}
+	}
+	return 0, false
+}
+
+// generate вычисляет код для шага counter (HOTP, RFC 4226)
+func generate(key []byte, counter int64) string {
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	// Динамическое усечение
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", digits, value%1000000)
+}
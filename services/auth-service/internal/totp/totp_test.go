@@ -0,0 +1,94 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+// rfcSecret - секрет тестовых векторов RFC 6238 (SHA1) в base32
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCodeRFC6238Vectors(t *testing.T) {
+	// Шестизначные коды - последние цифры восьмизначных значений из RFC 6238, приложение B
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Code(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidateWindow(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	current := now.Unix() / int64(period.Seconds())
+
+	tests := []struct {
+		name     string
+		codeAt   time.Time // Момент, для которого вычислен код
+		wantOK   bool
+		wantStep int64
+	}{
+		{"current step", now, true, current},
+		{"previous step", now.Add(-period), true, current - 1},
+		{"next step", now.Add(period), true, current + 1},
+		{"two steps ago", now.Add(-2 * period), false, 0},
+		{"two steps ahead", now.Add(2 * period), false, 0},
+		{"an hour ago", now.Add(-time.Hour), false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := Code(rfcSecret, tt.codeAt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			step, ok := Validate(rfcSecret, code, now)
+			if ok != tt.wantOK || step != tt.wantStep {
+				t.Errorf("Validate = (%d, %v), want (%d, %v)", step, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestValidateInput(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+
+	tests := []struct {
+		name   string
+		secret string
+		code   string
+		wantOK bool
+	}{
+		{"code with spaces", rfcSecret, "005 924", true},
+		{"lowercase secret", "gezdgnbvgy3tqojqgezdgnbvgy3tqojq", "005924", true},
+		{"wrong code", rfcSecret, "005925", false},
+		{"short code", rfcSecret, "05924", false},
+		{"long code", rfcSecret, "0005924", false},
+		{"empty code", rfcSecret, "", false},
+		{"invalid secret", "not base32!", "005924", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := Validate(tt.secret, tt.code, now); ok != tt.wantOK {
+				t.Errorf("Validate(%q) ok = %v, want %v", tt.code, ok, tt.wantOK)
+			}
+		})
+	}
+}
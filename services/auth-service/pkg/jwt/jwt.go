@@ -18,53 +18,69 @@ var (
 type TokenType string
 
 const (
-	AccessToken  TokenType = "access"
-	RefreshToken TokenType = "refresh"
+	AccessToken            TokenType = "access"
+	RefreshToken           TokenType = "refresh"
+	EmailVerificationToken TokenType = "email_verification"
+	MFAChallengeToken      TokenType = "mfa_challenge"
 )
 
+// Subject описывает пользователя, для которого выпускается токен
+type Subject struct {
+	UserID        uuid.UUID
+	Email         string
+	Role          string
+	SessionID     string // Сеанс входа (пусто для токенов вне сеанса)
+	EmailVerified bool
+}
+
 // Claims представляет данные, хранящиеся в JWT токене
 type Claims struct {
-	UserID    uuid.UUID `json:"user_id"`
-	Email     string    `json:"email"`
-	Role      string    `json:"role"`
-	TokenType TokenType `json:"token_type"`
+	UserID        uuid.UUID `json:"user_id"`
+	Email         string    `json:"email"`
+	Role          string    `json:"role"`
+	SessionID     string    `json:"sid,omitempty"` // Сеанс входа, к которому относится токен
+	EmailVerified bool      `json:"email_verified"`
+	TokenType     TokenType `json:"token_type"`
 	jwt.RegisteredClaims
 }
 
-// JWTManager управляет созданием и валидацией JWT токенов
+// JWTManager управляет созданием и валидацией JWT токенов.
+// Токены подписываются асимметричными ключами (RS256 или EdDSA): другие сервисы проверяют
+// подпись по открытым ключам из JWKS и не могут выпускать токены сами.
 type JWTManager struct {
-	secretKey       string
+	keys            *KeySet
 	accessDuration  time.Duration
 	refreshDuration time.Duration
 }
 
 // NewJWTManager создаёт новый экземпляр JWTManager
-func NewJWTManager(secretKey string, accessExpirationHours int) *JWTManager {
+func NewJWTManager(keys *KeySet, accessExpirationHours int) *JWTManager {
 	return &JWTManager{
-		secretKey:       secretKey,
+		keys:            keys,
 		accessDuration:  time.Duration(accessExpirationHours) * time.Hour,
 		refreshDuration: time.Duration(accessExpirationHours*7) * time.Hour, // Refresh токен живёт в 7 раз дольше
 	}
 }
 
-// GenerateAccessToken создаёт новый access токен
-func (m *JWTManager) GenerateAccessToken(userID uuid.UUID, email, role string) (string, error) {
-	return m.generateToken(userID, email, role, AccessToken, m.accessDuration)
+// GenerateAccessToken создаёт новый access токен в рамках сеанса
+func (m *JWTManager) GenerateAccessToken(subject Subject) (string, error) {
+	return m.generateToken(subject, AccessToken, uuid.NewString(), m.accessDuration)
 }
 
-// GenerateRefreshToken создаёт новый refresh токен
-func (m *JWTManager) GenerateRefreshToken(userID uuid.UUID, email, role string) (string, error) {
-	return m.generateToken(userID, email, role, RefreshToken, m.refreshDuration)
+// GenerateRefreshToken создаёт новый refresh токен с указанным идентификатором (jti),
+// по которому токен отслеживается в хранилище на стороне сервера
+func (m *JWTManager) GenerateRefreshToken(subject Subject, tokenID string) (string, error) {
+	return m.generateToken(subject, RefreshToken, tokenID, m.refreshDuration)
 }
 
-// GenerateTokenPair создаёт пару access и refresh токенов
-func (m *JWTManager) GenerateTokenPair(userID uuid.UUID, email, role string) (accessToken, refreshToken string, err error) {
-	accessToken, err = m.GenerateAccessToken(userID, email, role)
+// GenerateTokenPair создаёт пару access и refresh токенов в рамках сеанса
+func (m *JWTManager) GenerateTokenPair(subject Subject, refreshTokenID string) (accessToken, refreshToken string, err error) {
+	accessToken, err = m.GenerateAccessToken(subject)
 	if err != nil {
 		return "", "", err
 	}
 
-	refreshToken, err = m.GenerateRefreshToken(userID, email, role)
+	refreshToken, err = m.GenerateRefreshToken(subject, refreshTokenID)
 	if err != nil {
 		return "", "", err
 	}
@@ -72,15 +88,23 @@ func (m *JWTManager) GenerateTokenPair(userID uuid.UUID, email, role string) (ac
 	return accessToken, refreshToken, nil
 }
 
+// GenerateEmailVerificationToken создаёт подписанный токен подтверждения email.
+// Одноразовость обеспечивается хранилищем по идентификатору tokenID (jti).
+func (m *JWTManager) GenerateEmailVerificationToken(userID uuid.UUID, email, tokenID string, duration time.Duration) (string, error) {
+	return m.generateToken(Subject{UserID: userID, Email: email}, EmailVerificationToken, tokenID, duration)
+}
+
+// GenerateMFAChallengeToken создаёт короткоживущий токен, подтверждающий верный пароль.
+// Пара токенов выдаётся только после предъявления этого токена вместе с кодом второго фактора.
+func (m *JWTManager) GenerateMFAChallengeToken(subject Subject, duration time.Duration) (string, error) {
+	return m.generateToken(subject, MFAChallengeToken, uuid.NewString(), duration)
+}
+
 // ValidateToken проверяет токен и возвращает claims
 func (m *JWTManager) ValidateToken(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		// Проверка метода подписи
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, ErrInvalidToken
-		}
-		return []byte(m.secretKey), nil
-	})
+	// Ключ проверки выбирается по kid; токены, подписанные общим секретом (HS256), не принимаются
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, m.keys.verificationKey,
+		jwt.WithValidMethods([]string{AlgorithmRS256, AlgorithmEdDSA}))
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
@@ -125,21 +149,62 @@ func (m *JWTManager) ValidateRefreshToken(tokenString string) (*Claims, error) {
 	return claims, nil
 }
 
+// ValidateEmailVerificationToken проверяет, что токен является токеном подтверждения email
+func (m *JWTManager) ValidateEmailVerificationToken(tokenString string) (*Claims, error) {
+	claims, err := m.ValidateToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.TokenType != EmailVerificationToken {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// ValidateMFAChallengeToken проверяет, что токен является токеном второго шага входа
+func (m *JWTManager) ValidateMFAChallengeToken(tokenString string) (*Claims, error) {
+	claims, err := m.ValidateToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.TokenType != MFAChallengeToken {
+		return nil, ErrInvalidToken
+	}
+
+	return claims, nil
+}
+
+// JWKS возвращает открытые ключи для проверки подписи токенов
+func (m *JWTManager) JWKS() JWKS {
+	return m.keys.JWKS()
+}
+
 // GetAccessDuration возвращает время жизни access токена в секундах
 func (m *JWTManager) GetAccessDuration() int64 {
 	return int64(m.accessDuration.Seconds())
 }
 
+// GetRefreshDuration возвращает время жизни refresh токена
+func (m *JWTManager) GetRefreshDuration() time.Duration {
+	return m.refreshDuration
+}
+
 // generateToken создаёт JWT токен с указанными параметрами
-func (m *JWTManager) generateToken(userID uuid.UUID, email, role string, tokenType TokenType, duration time.Duration) (string, error) {
+func (m *JWTManager) generateToken(subject Subject, tokenType TokenType, tokenID string, duration time.Duration) (string, error) {
 	now := time.Now()
 
 	claims := &Claims{
-		UserID:    userID,
-		Email:     email,
-		Role:      role,
-		TokenType: tokenType,
+		UserID:        subject.UserID,
+		Email:         subject.Email,
+		Role:          subject.Role,
+		SessionID:     subject.SessionID,
+		EmailVerified: subject.EmailVerified,
+		TokenType:     tokenType,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID,
 			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
@@ -147,6 +212,8 @@ func (m *JWTManager) generateToken(userID uuid.UUID, email, role string, tokenTy
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(m.secretKey))
+	key := m.keys.active
+	token := jwt.NewWithClaims(key.method(), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.privateKey)
 }
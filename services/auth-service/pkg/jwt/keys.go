@@ -0,0 +1,234 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Поддерживаемые алгоритмы подписи
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// rsaKeyBits - размер генерируемых RSA ключей
+const rsaKeyBits = 2048
+
+// Ошибки ключей подписи
+var (
+	ErrUnknownKey           = errors.New("неизвестный ключ подписи")
+	ErrUnsupportedAlgorithm = errors.New("неподдерживаемый алгоритм подписи")
+)
+
+// SigningKey - закрытый ключ подписи с идентификатором (kid)
+type SigningKey struct {
+	ID         string
+	Algorithm  string
+	privateKey crypto.Signer
+}
+
+// method возвращает метод подписи golang-jwt для ключа
+func (k *SigningKey) method() jwt.SigningMethod {
+	if k.Algorithm == AlgorithmRS256 {
+		return jwt.SigningMethodRS256
+	}
+	return jwt.SigningMethodEdDSA
+}
+
+// JWK представляет открытый ключ в формате JSON Web Key (RFC 7517)
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Algorithm string `json:"alg"`
+	Use       string `json:"use"`
+	Curve     string `json:"crv,omitempty"` // OKP (Ed25519)
+	X         string `json:"x,omitempty"`   // OKP (Ed25519)
+	N         string `json:"n,omitempty"`   // RSA
+	E         string `json:"e,omitempty"`   // RSA
+}
+
+// JWKS представляет набор открытых ключей (/.well-known/jwks.json)
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// KeySet - ключи подписи: одним (активным) подписываются новые токены,
+// остальные принимаются при проверке, пока не истекут выданные ими токены
+type KeySet struct {
+	active *SigningKey
+	keys   map[string]*SigningKey
+}
+
+// LoadKeySet загружает ключи из каталога dir: каждый файл "<kid>.pem" содержит закрытый ключ
+// RSA или Ed25519 в формате PKCS#8. Активным становится ключ activeID, а если он не задан -
+// ключ с наибольшим kid (удобно именовать ключи датой выпуска).
+// Если в каталоге нет ключей, создаётся новый ключ алгоритма algorithm.
+//
+// Ротация: положить в каталог новый ключ и перезапустить сервис (или указать его в activeID);
+// старый ключ удаляется после истечения выданных им refresh токенов.
+func LoadKeySet(dir, activeID, algorithm string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(paths) == 0 {
+		path, err := generateKeyFile(dir, algorithm)
+		if err != nil {
+			return nil, err
+		}
+		paths = []string{path}
+	}
+
+	set := &KeySet{keys: make(map[string]*SigningKey, len(paths))}
+	for _, path := range paths {
+		key, err := readKeyFile(path)
+		if err != nil {
+			return nil, err
+		}
+		set.keys[key.ID] = key
+	}
+
+	if activeID == "" {
+		ids := make([]string, 0, len(set.keys))
+		for id := range set.keys {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		activeID = ids[len(ids)-1]
+	}
+
+	active, ok := set.keys[activeID]
+	if !ok {
+		return nil, fmt.Errorf("активный ключ %q не найден в %s", activeID, dir)
+	}
+	set.active = active
+
+	return set, nil
+}
+
+// ActiveKeyID возвращает kid ключа, которым подписываются новые токены
+func (s *KeySet) ActiveKeyID() string {
+	return s.active.ID
+}
+
+// JWKS возвращает открытые части всех ключей набора
+func (s *KeySet) JWKS() JWKS {
+	ids := make([]string, 0, len(s.keys))
+	for id := range s.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	jwks := JWKS{Keys: make([]JWK, 0, len(ids))}
+	for _, id := range ids {
+		key := s.keys[id]
+		jwk := JWK{KeyID: key.ID, Algorithm: key.Algorithm, Use: "sig"}
+
+		switch public := key.privateKey.Public().(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		}
+
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+	return jwks
+}
+
+// verificationKey возвращает открытый ключ для проверки подписи токена по его kid и алгоритму
+func (s *KeySet) verificationKey(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+
+	// Алгоритм определяется ключом, а не заголовком токена
+	if token.Method.Alg() != key.Algorithm {
+		return nil, ErrUnsupportedAlgorithm
+	}
+	return key.privateKey.Public(), nil
+}
+
+// readKeyFile читает закрытый ключ PKCS#8; kid - имя файла без расширения
+func readKeyFile(path string) (*SigningKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("файл %s не содержит PEM блок", path)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка чтения ключа %s: %w", path, err)
+	}
+
+	key := &SigningKey{ID: strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))}
+	switch private := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Algorithm = AlgorithmRS256
+		key.privateKey = private
+	case ed25519.PrivateKey:
+		key.Algorithm = AlgorithmEdDSA
+		key.privateKey = private
+	default:
+		return nil, fmt.Errorf("ключ %s: %w", path, ErrUnsupportedAlgorithm)
+	}
+	return key, nil
+}
+
+// generateKeyFile создаёт новый закрытый ключ и сохраняет его в каталог dir
+func generateKeyFile(dir, algorithm string) (string, error) {
+	var private crypto.Signer
+	var err error
+
+	switch algorithm {
+	case AlgorithmEdDSA:
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	case AlgorithmRS256:
+		private, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, time.Now().UTC().Format("20060102-150405")+".pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		return "", err
+	}
+	return path, nil
+}
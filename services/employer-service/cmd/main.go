@@ -1 +1,66 @@
-package cmd
+package main
+
+import (
+	"employer-service/internal/config"
+	"employer-service/internal/handler"
+	"employer-service/internal/repository"
+	"employer-service/internal/router"
+	"employer-service/internal/service"
+	"log"
+	"observability/health"
+	"observability/server"
+)
+
+// @title Employer Service API
+// @version 1.0
+// @description Сервис работодателей для системы трудоустройства студентов
+// @host localhost:8083
+// @BasePath /api
+
+func main() {
+	// Загрузка конфигурации из переменных окружения
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Ошибка загрузки конфигурации: %v", err)
+	}
+
+	// Подключение к базе данных PostgreSQL
+	db, err := config.ConnectDatabase(cfg)
+	if err != nil {
+		log.Fatalf("Ошибка подключения к базе данных: %v", err)
+	}
+
+	// Инициализация слоёв приложения
+	companyRepo := repository.NewCompanyRepository(db)
+	recruiterRepo := repository.NewRecruiterRepository(db)
+	invitationRepo := repository.NewInvitationRepository(db)
+	employerService := service.NewEmployerService(companyRepo, recruiterRepo, invitationRepo)
+	employerHandler := handler.NewEmployerHandler(employerService)
+
+	// Пул соединений с БД проверяется в /health/ready и закрывается после завершения обрабатываемых запросов
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("Ошибка получения пула соединений с базой данных: %v", err)
+	}
+	readiness := server.NewReadiness()
+	healthHandler := health.NewHandler("employer-service", sqlDB, readiness, cfg.HealthCheckTimeout)
+
+	// Создание и настройка роутера
+	r := router.SetupRouter(employerHandler, healthHandler)
+
+	// Запуск HTTP сервера (до SIGINT/SIGTERM)
+	log.Printf("Employer Service запущен на порту %s", cfg.ServerPort)
+	err = server.Run(r, server.Config{
+		Addr:              ":" + cfg.ServerPort,
+		ReadTimeout:       cfg.ServerReadTimeout,
+		ReadHeaderTimeout: cfg.ServerReadHeaderTimeout,
+		WriteTimeout:      cfg.ServerWriteTimeout,
+		IdleTimeout:       cfg.ServerIdleTimeout,
+		ShutdownDelay:     cfg.ShutdownDelay,
+		ShutdownTimeout:   cfg.ShutdownTimeout,
+	}, readiness, sqlDB.Close)
+	if err != nil {
+		log.Fatalf("Ошибка работы сервера: %v", err)
+	}
+	log.Printf("Employer Service остановлен")
+}
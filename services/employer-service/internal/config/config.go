@@ -0,0 +1,88 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// Config содержит все настройки приложения
+type Config struct {
+	// Настройки сервера
+	ServerPort              string
+	ServerReadTimeout       time.Duration
+	ServerReadHeaderTimeout time.Duration
+	ServerWriteTimeout      time.Duration
+	ServerIdleTimeout       time.Duration
+	ShutdownDelay           time.Duration // Пауза после снятия готовности перед остановкой
+	ShutdownTimeout         time.Duration // Время на завершение обрабатываемых запросов
+	HealthCheckTimeout      time.Duration // Ограничение проверки базы данных в /health/ready
+
+	// Настройки базы данных PostgreSQL
+	DBHost     string
+	DBPort     string
+	DBUser     string
+	DBPassword string
+	DBName     string
+	DBSSLMode  string
+}
+
+// LoadConfig загружает конфигурацию из переменных окружения
+func LoadConfig() (*Config, error) {
+	// Попытка загрузить .env файл (игнорируем ошибку, если файл не найден)
+	_ = godotenv.Load()
+
+	config := &Config{
+		ServerPort: getEnv("SERVER_PORT", "8083"),
+		DBHost:     getEnv("DB_HOST", "localhost"),
+		DBPort:     getEnv("DB_PORT", "5432"),
+		DBUser:     getEnv("DB_USER", "postgres"),
+		DBPassword: getEnv("DB_PASSWORD", "Supoga80"),
+		DBName:     getEnv("DB_NAME", "postgres"),
+		DBSSLMode:  getEnv("DB_SSLMODE", "disable"),
+	}
+
+	// Парсинг таймаутов HTTP сервера, его остановки и проверки готовности. По умолчанию
+	// остановка укладывается в 10 секунд, которые Docker ждёт после SIGTERM
+	timeouts := []struct {
+		key   string
+		value *time.Duration
+		def   string
+	}{
+		{"SERVER_READ_TIMEOUT_SECONDS", &config.ServerReadTimeout, "15"},
+		{"SERVER_READ_HEADER_TIMEOUT_SECONDS", &config.ServerReadHeaderTimeout, "5"},
+		{"SERVER_WRITE_TIMEOUT_SECONDS", &config.ServerWriteTimeout, "30"},
+		{"SERVER_IDLE_TIMEOUT_SECONDS", &config.ServerIdleTimeout, "60"},
+		{"SHUTDOWN_DELAY_SECONDS", &config.ShutdownDelay, "2"},
+		{"SHUTDOWN_TIMEOUT_SECONDS", &config.ShutdownTimeout, "7"},
+		{"HEALTH_CHECK_TIMEOUT_SECONDS", &config.HealthCheckTimeout, "2"},
+	}
+	for _, timeout := range timeouts {
+		seconds, err := strconv.Atoi(getEnv(timeout.key, timeout.def))
+		if err != nil {
+			return nil, fmt.Errorf("некорректное значение %s: %v", timeout.key, err)
+		}
+		*timeout.value = time.Duration(seconds) * time.Second
+	}
+
+	return config, nil
+}
+
+// GetDSN возвращает строку подключения к PostgreSQL
+func (c *Config) GetDSN() string {
+	return fmt.Sprintf(
+		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		c.DBHost, c.DBPort, c.DBUser, c.DBPassword, c.DBName, c.DBSSLMode,
+	)
+}
+
+// getEnv получает значение переменной окружения или возвращает значение по умолчанию
+func getEnv(key, defaultValue string) string {
+	if value, exists := os.LookupEnv(key); exists {
+		return value
+	}
+	return defaultValue
+}
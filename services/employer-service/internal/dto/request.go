@@ -0,0 +1,31 @@
+package dto
+
+import "employer-service/internal/models"
+
+// CompanyRequest представляет запрос на создание или обновление профиля компании
+type CompanyRequest struct {
+	BIN          string             `json:"bin" binding:"required,len=12,numeric" example:"180840012345"`
+	LegalName    string             `json:"legalName" binding:"required,max=255" example:"ТОО Компания"`
+	ContactEmail string             `json:"contactEmail" binding:"required,email" example:"hr@company.kz"`
+	ContactPhone string             `json:"contactPhone" binding:"required,e164" example:"+77271234567"`
+	Industry     string             `json:"industry" binding:"max=100" example:"Информационные технологии"`
+	Size         models.CompanySize `json:"size" binding:"omitempty,oneof=1-10 11-50 51-200 201-1000 1000+" example:"51-200"`
+	LogoURL      string             `json:"logoUrl" binding:"omitempty,url,max=1024" example:"https://company.kz/logo.png"`
+}
+
+// AddRecruiterRequest представляет приглашение пользователя в компанию рекрутером
+type AddRecruiterRequest struct {
+	UserID string `json:"userId" binding:"required,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// RejectRequest представляет запрос на отклонение компании
+type RejectRequest struct {
+	Reason string `json:"reason" binding:"required,max=1000" example:"БИН не найден в реестре юридических лиц"`
+}
+
+// ListCompaniesQuery представляет параметры выборки компаний для проверки
+type ListCompaniesQuery struct {
+	Status   models.VerificationStatus `form:"status" binding:"omitempty,oneof=pending verified rejected" example:"pending"`
+	Page     int                       `form:"page" binding:"omitempty,min=1" example:"1"`
+	PageSize int                       `form:"pageSize" binding:"omitempty,min=1,max=100" example:"20"`
+}
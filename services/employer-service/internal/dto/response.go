@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"employer-service/internal/models"
+
+	"github.com/google/uuid"
+)
+
+// CompanyListResponse представляет страницу списка компаний
+type CompanyListResponse struct {
+	Items    []models.Company `json:"items"`
+	Total    int64            `json:"total" example:"42"`
+	Page     int              `json:"page" example:"1"`
+	PageSize int              `json:"pageSize" example:"20"`
+}
+
+// VerificationResponse представляет статус проверки компании рекрутера
+type VerificationResponse struct {
+	UserID             string                    `json:"userId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CompanyID          string                    `json:"companyId" example:"550e8400-e29b-41d4-a716-446655440001"`
+	VerificationStatus models.VerificationStatus `json:"verificationStatus" example:"verified"`
+	Verified           bool                      `json:"verified" example:"true"`
+}
+
+// VerifiedRecruitersResponse представляет пользователей, которые состоят в подтверждённых компаниях
+type VerifiedRecruitersResponse struct {
+	UserIDs []uuid.UUID `json:"userIds"`
+}
+
+// ErrorResponse представляет ответ с ошибкой
+type ErrorResponse struct {
+	Error   string            `json:"error" example:"Ошибка валидации"`
+	Message string            `json:"message" example:"Компания не найдена"`
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// SuccessResponse представляет успешный ответ без данных
+type SuccessResponse struct {
+	Message string `json:"message" example:"Операция выполнена успешно"`
+}
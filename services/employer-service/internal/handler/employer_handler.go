@@ -0,0 +1,583 @@
+package handler
+
+import (
+	"employer-service/internal/dto"
+	"employer-service/internal/repository"
+	"employer-service/internal/service"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// EmployerHandler обрабатывает HTTP запросы компаний, рекрутеров и проверки БИН
+type EmployerHandler struct {
+	employerService service.EmployerService
+}
+
+// NewEmployerHandler создаёт новый экземпляр обработчика работодателей
+func NewEmployerHandler(employerService service.EmployerService) *EmployerHandler {
+	return &EmployerHandler{
+		employerService: employerService,
+	}
+}
+
+// CreateCompany регистрирует компанию текущего пользователя
+// @Summary Регистрация компании
+// @Description Создаёт профиль компании в состоянии ожидания проверки; текущий пользователь становится владельцем
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Param request body dto.CompanyRequest true "Данные компании"
+// @Success 201 {object} models.Company
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /employers/companies [post]
+func (h *EmployerHandler) CreateCompany(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CompanyRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	company, err := h.employerService.CreateCompany(userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, company)
+}
+
+// GetMyCompany возвращает компанию текущего пользователя
+// @Summary Моя компания
+// @Description Возвращает компанию, в которой состоит текущий пользователь
+// @Tags companies
+// @Produce json
+// @Success 200 {object} models.Company
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /employers/companies/my [get]
+func (h *EmployerHandler) GetMyCompany(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	company, err := h.employerService.GetMyCompany(userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, company)
+}
+
+// UpdateMyCompany обновляет профиль компании текущего пользователя
+// @Summary Обновление компании
+// @Description Обновляет профиль компании; изменение БИН или наименования отправляет компанию на повторную проверку
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Param request body dto.CompanyRequest true "Данные компании"
+// @Success 200 {object} models.Company
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /employers/companies/my [put]
+func (h *EmployerHandler) UpdateMyCompany(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.CompanyRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	company, err := h.employerService.UpdateMyCompany(userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, company)
+}
+
+// GetCompany возвращает профиль компании по ID
+// @Summary Получение компании
+// @Description Возвращает профиль компании
+// @Tags companies
+// @Produce json
+// @Param id path string true "ID компании"
+// @Success 200 {object} models.Company
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /employers/companies/{id} [get]
+func (h *EmployerHandler) GetCompany(c *gin.Context) {
+	companyID, ok := uuidParam(c, "id", "Некорректный ID компании")
+	if !ok {
+		return
+	}
+
+	company, err := h.employerService.GetCompany(companyID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, company)
+}
+
+// ListRecruiters возвращает рекрутеров компании текущего пользователя
+// @Summary Рекрутеры компании
+// @Description Возвращает всех рекрутеров компании текущего пользователя
+// @Tags recruiters
+// @Produce json
+// @Success 200 {array} models.Recruiter
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /employers/companies/my/recruiters [get]
+func (h *EmployerHandler) ListRecruiters(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	recruiters, err := h.employerService.ListRecruiters(userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, recruiters)
+}
+
+// InviteRecruiter приглашает пользователя в компанию рекрутером
+// @Summary Приглашение рекрутера
+// @Description Приглашает пользователя auth-service в компанию текущего владельца; рекрутером он станет, приняв приглашение
+// @Tags recruiters
+// @Accept json
+// @Produce json
+// @Param request body dto.AddRecruiterRequest true "Пользователь"
+// @Success 201 {object} models.RecruiterInvitation
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /employers/companies/my/recruiters [post]
+func (h *EmployerHandler) InviteRecruiter(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.AddRecruiterRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	invitation, err := h.employerService.InviteRecruiter(userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, invitation)
+}
+
+// ListRecruiterInvitations возвращает приглашения компании текущего владельца
+// @Summary Приглашения компании
+// @Description Возвращает приглашения рекрутеров, ожидающие ответа
+// @Tags recruiters
+// @Produce json
+// @Success 200 {array} models.RecruiterInvitation
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /employers/companies/my/invitations [get]
+func (h *EmployerHandler) ListRecruiterInvitations(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	invitations, err := h.employerService.ListRecruiterInvitations(userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invitations)
+}
+
+// CancelRecruiterInvitation отзывает приглашение рекрутера
+// @Summary Отзыв приглашения
+// @Description Отзывает приглашение пользователя в компанию текущего владельца
+// @Tags recruiters
+// @Produce json
+// @Param userId path string true "ID пользователя"
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /employers/companies/my/invitations/{userId} [delete]
+func (h *EmployerHandler) CancelRecruiterInvitation(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	invitedUserID, ok := uuidParam(c, "userId", "Некорректный ID пользователя")
+	if !ok {
+		return
+	}
+
+	if err := h.employerService.CancelRecruiterInvitation(userID, invitedUserID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{Message: "Приглашение отозвано"})
+}
+
+// ListMyInvitations возвращает приглашения текущего пользователя
+// @Summary Мои приглашения
+// @Description Возвращает приглашения текущего пользователя стать рекрутером компании
+// @Tags recruiters
+// @Produce json
+// @Success 200 {array} models.RecruiterInvitation
+// @Router /employers/invitations [get]
+func (h *EmployerHandler) ListMyInvitations(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	invitations, err := h.employerService.ListMyInvitations(userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, invitations)
+}
+
+// AcceptInvitation принимает приглашение в компанию
+// @Summary Принятие приглашения
+// @Description Добавляет текущего пользователя в пригласившую компанию рекрутером
+// @Tags recruiters
+// @Produce json
+// @Param companyId path string true "ID компании"
+// @Success 201 {object} models.Recruiter
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /employers/invitations/{companyId}/accept [post]
+func (h *EmployerHandler) AcceptInvitation(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	companyID, ok := uuidParam(c, "companyId", "Некорректный ID компании")
+	if !ok {
+		return
+	}
+
+	recruiter, err := h.employerService.AcceptInvitation(userID, companyID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, recruiter)
+}
+
+// DeclineInvitation отклоняет приглашение в компанию
+// @Summary Отказ от приглашения
+// @Description Удаляет приглашение текущего пользователя в компанию
+// @Tags recruiters
+// @Produce json
+// @Param companyId path string true "ID компании"
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /employers/invitations/{companyId}/decline [post]
+func (h *EmployerHandler) DeclineInvitation(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	companyID, ok := uuidParam(c, "companyId", "Некорректный ID компании")
+	if !ok {
+		return
+	}
+
+	if err := h.employerService.DeclineInvitation(userID, companyID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{Message: "Приглашение отклонено"})
+}
+
+// RemoveRecruiter удаляет рекрутера из компании
+// @Summary Удаление рекрутера
+// @Description Отвязывает рекрутера от компании текущего владельца
+// @Tags recruiters
+// @Produce json
+// @Param userId path string true "ID пользователя"
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /employers/companies/my/recruiters/{userId} [delete]
+func (h *EmployerHandler) RemoveRecruiter(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	recruiterUserID, ok := uuidParam(c, "userId", "Некорректный ID пользователя")
+	if !ok {
+		return
+	}
+
+	if err := h.employerService.RemoveRecruiter(userID, recruiterUserID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{Message: "Рекрутер удалён из компании"})
+}
+
+// ListVerifications возвращает компании для проверки
+// @Summary Заявки на проверку
+// @Description Возвращает компании с фильтром по состоянию проверки (для университетов и администраторов)
+// @Tags verification
+// @Produce json
+// @Param status query string false "Состояние проверки"
+// @Param page query int false "Номер страницы"
+// @Param pageSize query int false "Размер страницы"
+// @Success 200 {object} dto.CompanyListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /employers/verifications [get]
+func (h *EmployerHandler) ListVerifications(c *gin.Context) {
+	var query dto.ListCompaniesQuery
+
+	// Парсинг и валидация параметров запроса
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response, err := h.employerService.ListCompanies(&query)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Verify подтверждает компанию
+// @Summary Подтверждение компании
+// @Description Подтверждает БИН компании, после чего её рекрутеры могут публиковать вакансии
+// @Tags verification
+// @Produce json
+// @Param id path string true "ID компании"
+// @Success 200 {object} models.Company
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /employers/verifications/{id}/verify [post]
+func (h *EmployerHandler) Verify(c *gin.Context) {
+	reviewerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	companyID, ok := uuidParam(c, "id", "Некорректный ID компании")
+	if !ok {
+		return
+	}
+
+	company, err := h.employerService.Verify(reviewerID, companyID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, company)
+}
+
+// Reject отклоняет компанию
+// @Summary Отклонение компании
+// @Description Отклоняет заявку компании или отзывает подтверждение с указанием причины
+// @Tags verification
+// @Accept json
+// @Produce json
+// @Param id path string true "ID компании"
+// @Param request body dto.RejectRequest true "Причина отклонения"
+// @Success 200 {object} models.Company
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /employers/verifications/{id}/reject [post]
+func (h *EmployerHandler) Reject(c *gin.Context) {
+	reviewerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	companyID, ok := uuidParam(c, "id", "Некорректный ID компании")
+	if !ok {
+		return
+	}
+
+	var req dto.RejectRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	company, err := h.employerService.Reject(reviewerID, companyID, req.Reason)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, company)
+}
+
+// GetVerification возвращает состояние проверки компании пользователя.
+// Внутренний эндпоинт для других сервисов, не проксируется API Gateway.
+func (h *EmployerHandler) GetVerification(c *gin.Context) {
+	userID, ok := uuidParam(c, "userId", "Некорректный ID пользователя")
+	if !ok {
+		return
+	}
+
+	response, err := h.employerService.GetVerification(userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListVerifiedRecruiters возвращает пользователей, состоящих в подтверждённых компаниях.
+// Внутренний эндпоинт для других сервисов, не проксируется API Gateway.
+func (h *EmployerHandler) ListVerifiedRecruiters(c *gin.Context) {
+	response, err := h.employerService.ListVerifiedRecruiters()
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// bindJSON парсит и валидирует тело запроса
+func bindJSON(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return false
+	}
+	return true
+}
+
+// currentUserID извлекает ID пользователя из контекста (установлен middleware).
+// При ошибке ответ уже отправлен клиенту и возвращается false.
+func currentUserID(c *gin.Context) (uuid.UUID, bool) {
+	userID, exists := c.Get("user_id")
+	id, ok := userID.(uuid.UUID)
+	if !exists || !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "Не авторизован",
+			Message: "Требуется аутентификация",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// uuidParam извлекает UUID из параметра пути запроса
+func uuidParam(c *gin.Context, name, message string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(name))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: message,
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// handleServiceError обрабатывает ошибки сервиса и возвращает соответствующий HTTP ответ
+func handleServiceError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrCompanyNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Не найдено",
+			Message: "Компания не найдена",
+		})
+	case errors.Is(err, repository.ErrRecruiterNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Не найдено",
+			Message: "Пользователь не состоит в компании",
+		})
+	case errors.Is(err, repository.ErrInvitationNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Не найдено",
+			Message: "Приглашение не найдено",
+		})
+	case errors.Is(err, repository.ErrBINAlreadyExists):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Компания с таким БИН уже зарегистрирована",
+		})
+	case errors.Is(err, repository.ErrRecruiterAlreadyExists):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Пользователь уже состоит в компании",
+		})
+	case errors.Is(err, repository.ErrInvitationAlreadyExists):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Пользователь уже приглашён в компанию",
+		})
+	case errors.Is(err, service.ErrSelfInvitation):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Владелец уже состоит в компании",
+		})
+	case errors.Is(err, service.ErrNotCompanyOwner):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "Доступ запрещён",
+			Message: "Действие доступно только владельцу компании",
+		})
+	case errors.Is(err, service.ErrOwnerRemoval):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Владельца нельзя удалить из компании",
+		})
+	case errors.Is(err, service.ErrInvalidVerificationTransition):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Недопустимый переход состояния проверки",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Внутренняя ошибка сервера",
+			Message: "Произошла непредвиденная ошибка",
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// VerificationStatus определяет состояние проверки компании по БИН
+type VerificationStatus string
+
+const (
+	VerificationPending  VerificationStatus = "pending"  // Ожидает проверки
+	VerificationVerified VerificationStatus = "verified" // Подтверждена, может публиковать вакансии
+	VerificationRejected VerificationStatus = "rejected" // Отклонена
+)
+
+// CanTransitionTo проверяет, допустим ли переход в указанное состояние
+func (s VerificationStatus) CanTransitionTo(next VerificationStatus) bool {
+	switch s {
+	case VerificationPending:
+		return next == VerificationVerified || next == VerificationRejected
+	case VerificationVerified:
+		return next == VerificationRejected // Отзыв подтверждения
+	case VerificationRejected:
+		return next == VerificationPending // Повторная подача после исправления данных
+	}
+	return false
+}
+
+// CompanySize определяет численность сотрудников компании
+type CompanySize string
+
+const (
+	SizeMicro      CompanySize = "1-10"
+	SizeSmall      CompanySize = "11-50"
+	SizeMedium     CompanySize = "51-200"
+	SizeLarge      CompanySize = "201-1000"
+	SizeEnterprise CompanySize = "1000+"
+)
+
+// Company представляет профиль компании работодателя
+type Company struct {
+	ID                 uuid.UUID          `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	BIN                string             `gorm:"type:varchar(12);uniqueIndex;not null" json:"bin"`
+	LegalName          string             `gorm:"type:varchar(255);not null" json:"legalName"`
+	ContactEmail       string             `gorm:"type:varchar(255);not null" json:"contactEmail"`
+	ContactPhone       string             `gorm:"type:varchar(20);not null" json:"contactPhone"`
+	Industry           string             `gorm:"type:varchar(100)" json:"industry"`
+	Size               CompanySize        `gorm:"type:varchar(16)" json:"size"`
+	LogoURL            string             `gorm:"type:varchar(1024)" json:"logoUrl"`
+	VerificationStatus VerificationStatus `gorm:"type:varchar(16);index;not null;default:'pending'" json:"verificationStatus"`
+	RejectionReason    string             `gorm:"type:text" json:"rejectionReason,omitempty"`
+	ReviewedBy         *uuid.UUID         `gorm:"type:uuid" json:"reviewedBy,omitempty"`
+	ReviewedAt         *time.Time         `json:"reviewedAt,omitempty"`
+	CreatedAt          time.Time          `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt          time.Time          `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName возвращает имя таблицы для модели Company
+func (Company) TableName() string {
+	return "companies"
+}
+
+// BeforeCreate выполняется перед созданием записи
+func (c *Company) BeforeCreate(tx *gorm.DB) error {
+	// Генерация UUID если не задан
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
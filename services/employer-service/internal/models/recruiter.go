@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecruiterRole определяет права рекрутера внутри компании
+type RecruiterRole string
+
+const (
+	RecruiterOwner  RecruiterRole = "owner"     // Создатель компании, управляет профилем и рекрутерами
+	RecruiterMember RecruiterRole = "recruiter" // Рекрутер, работает с вакансиями компании
+)
+
+// Recruiter связывает пользователя auth-service с компанией.
+// Пользователь может состоять только в одной компании.
+type Recruiter struct {
+	UserID    uuid.UUID     `gorm:"type:uuid;primary_key" json:"userId"`
+	CompanyID uuid.UUID     `gorm:"type:uuid;index;not null" json:"companyId"`
+	Role      RecruiterRole `gorm:"type:varchar(16);not null;default:'recruiter'" json:"role"`
+	CreatedAt time.Time     `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName возвращает имя таблицы для модели Recruiter
+func (Recruiter) TableName() string {
+	return "recruiters"
+}
+
+// RecruiterInvitation - приглашение пользователя в компанию. Рекрутером пользователь становится,
+// только приняв приглашение сам: так владелец не может привязать к компании чужую учётную запись.
+type RecruiterInvitation struct {
+	CompanyID uuid.UUID `gorm:"type:uuid;primary_key" json:"companyId"`
+	UserID    uuid.UUID `gorm:"type:uuid;primary_key;index" json:"userId"`
+	InvitedBy uuid.UUID `gorm:"type:uuid;not null" json:"invitedBy"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName возвращает имя таблицы для модели RecruiterInvitation
+func (RecruiterInvitation) TableName() string {
+	return "recruiter_invitations"
+}
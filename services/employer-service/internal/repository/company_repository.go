@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"employer-service/internal/models"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Ошибки репозитория компаний
+var (
+	ErrCompanyNotFound  = errors.New("компания не найдена")
+	ErrBINAlreadyExists = errors.New("компания с таким БИН уже зарегистрирована")
+)
+
+// CompanyRepository определяет интерфейс для работы с компаниями в БД
+type CompanyRepository interface {
+	CreateWithOwner(company *models.Company, owner *models.Recruiter) error
+	FindByID(id uuid.UUID) (*models.Company, error)
+	Update(company *models.Company) error
+	ExistsByBIN(bin string, excludeID uuid.UUID) (bool, error)
+	List(status models.VerificationStatus, offset, limit int) ([]models.Company, int64, error)
+}
+
+// companyRepository реализует CompanyRepository
+type companyRepository struct {
+	db *gorm.DB
+}
+
+// NewCompanyRepository создаёт новый экземпляр репозитория компаний
+func NewCompanyRepository(db *gorm.DB) CompanyRepository {
+	return &companyRepository{db: db}
+}
+
+// CreateWithOwner создаёт компанию и её владельца в одной транзакции
+func (r *companyRepository) CreateWithOwner(company *models.Company, owner *models.Recruiter) error {
+	// Проверка на существование компании с таким БИН
+	exists, err := r.ExistsByBIN(company.BIN, uuid.Nil)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrBINAlreadyExists
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(company).Error; err != nil {
+			return err
+		}
+
+		owner.CompanyID = company.ID
+		return tx.Create(owner).Error
+	})
+}
+
+// FindByID находит компанию по UUID
+func (r *companyRepository) FindByID(id uuid.UUID) (*models.Company, error) {
+	var company models.Company
+	if err := r.db.Where("id = ?", id).First(&company).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCompanyNotFound
+		}
+		return nil, err
+	}
+	return &company, nil
+}
+
+// Update обновляет данные компании
+func (r *companyRepository) Update(company *models.Company) error {
+	// Проверка уникальности БИН среди других компаний
+	exists, err := r.ExistsByBIN(company.BIN, company.ID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrBINAlreadyExists
+	}
+
+	result := r.db.Save(company)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrCompanyNotFound
+	}
+	return nil
+}
+
+// ExistsByBIN проверяет существование другой компании с указанным БИН
+func (r *companyRepository) ExistsByBIN(bin string, excludeID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.Company{}).
+		Where("bin = ? AND id <> ?", bin, excludeID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// List возвращает страницу компаний в указанном состоянии проверки и их общее количество
+func (r *companyRepository) List(status models.VerificationStatus, offset, limit int) ([]models.Company, int64, error) {
+	query := r.db.Model(&models.Company{})
+	if status != "" {
+		query = query.Where("verification_status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	companies := make([]models.Company, 0)
+	err := query.
+		Order("created_at ASC"). // Первыми проверяются самые старые заявки
+		Offset(offset).
+		Limit(limit).
+		Find(&companies).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return companies, total, nil
+}
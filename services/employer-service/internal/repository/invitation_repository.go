@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"employer-service/internal/models"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Ошибки репозитория приглашений
+var (
+	ErrInvitationNotFound      = errors.New("приглашение не найдено")
+	ErrInvitationAlreadyExists = errors.New("пользователь уже приглашён в компанию")
+)
+
+// InvitationRepository определяет интерфейс для работы с приглашениями рекрутеров в БД
+type InvitationRepository interface {
+	Create(invitation *models.RecruiterInvitation) error
+	Find(companyID, userID uuid.UUID) (*models.RecruiterInvitation, error)
+	ListByCompany(companyID uuid.UUID) ([]models.RecruiterInvitation, error)
+	ListByUser(userID uuid.UUID) ([]models.RecruiterInvitation, error)
+	Delete(companyID, userID uuid.UUID) error
+	Accept(invitation *models.RecruiterInvitation, recruiter *models.Recruiter) error
+}
+
+// invitationRepository реализует InvitationRepository
+type invitationRepository struct {
+	db *gorm.DB
+}
+
+// NewInvitationRepository создаёт новый экземпляр репозитория приглашений
+func NewInvitationRepository(db *gorm.DB) InvitationRepository {
+	return &invitationRepository{db: db}
+}
+
+// Create сохраняет приглашение
+func (r *invitationRepository) Create(invitation *models.RecruiterInvitation) error {
+	_, err := r.Find(invitation.CompanyID, invitation.UserID)
+	if err == nil {
+		return ErrInvitationAlreadyExists
+	}
+	if !errors.Is(err, ErrInvitationNotFound) {
+		return err
+	}
+
+	return r.db.Create(invitation).Error
+}
+
+// Find находит приглашение пользователя в компанию
+func (r *invitationRepository) Find(companyID, userID uuid.UUID) (*models.RecruiterInvitation, error) {
+	var invitation models.RecruiterInvitation
+	if err := r.db.Where("company_id = ? AND user_id = ?", companyID, userID).First(&invitation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvitationNotFound
+		}
+		return nil, err
+	}
+	return &invitation, nil
+}
+
+// ListByCompany возвращает ожидающие ответа приглашения компании
+func (r *invitationRepository) ListByCompany(companyID uuid.UUID) ([]models.RecruiterInvitation, error) {
+	invitations := make([]models.RecruiterInvitation, 0)
+	err := r.db.
+		Where("company_id = ?", companyID).
+		Order("created_at ASC").
+		Find(&invitations).Error
+	if err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// ListByUser возвращает приглашения пользователя
+func (r *invitationRepository) ListByUser(userID uuid.UUID) ([]models.RecruiterInvitation, error) {
+	invitations := make([]models.RecruiterInvitation, 0)
+	err := r.db.
+		Where("user_id = ?", userID).
+		Order("created_at ASC").
+		Find(&invitations).Error
+	if err != nil {
+		return nil, err
+	}
+	return invitations, nil
+}
+
+// Delete удаляет приглашение (отзыв владельцем или отказ пользователя)
+func (r *invitationRepository) Delete(companyID, userID uuid.UUID) error {
+	result := r.db.Delete(&models.RecruiterInvitation{}, "company_id = ? AND user_id = ?", companyID, userID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInvitationNotFound
+	}
+	return nil
+}
+
+// Accept добавляет пользователя в компанию и удаляет все его приглашения в одной транзакции.
+// Первичный ключ recruiters.user_id не даёт состоять в двух компаниях даже при одновременном принятии.
+func (r *invitationRepository) Accept(invitation *models.RecruiterInvitation, recruiter *models.Recruiter) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.Recruiter{}).Where("user_id = ?", recruiter.UserID).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrRecruiterAlreadyExists
+		}
+
+		if err := tx.Create(recruiter).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.RecruiterInvitation{}, "user_id = ?", invitation.UserID).Error
+	})
+}
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"employer-service/internal/models"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Ошибки репозитория рекрутеров
+var (
+	ErrRecruiterNotFound      = errors.New("рекрутер не найден")
+	ErrRecruiterAlreadyExists = errors.New("пользователь уже состоит в компании")
+)
+
+// RecruiterRepository определяет интерфейс для работы с рекрутерами в БД
+type RecruiterRepository interface {
+	FindByUserID(userID uuid.UUID) (*models.Recruiter, error)
+	ListByCompany(companyID uuid.UUID) ([]models.Recruiter, error)
+	ListUserIDsByCompanyStatus(status models.VerificationStatus) ([]uuid.UUID, error)
+	Delete(userID uuid.UUID) error
+}
+
+// recruiterRepository реализует RecruiterRepository
+type recruiterRepository struct {
+	db *gorm.DB
+}
+
+// NewRecruiterRepository создаёт новый экземпляр репозитория рекрутеров
+func NewRecruiterRepository(db *gorm.DB) RecruiterRepository {
+	return &recruiterRepository{db: db}
+}
+
+// FindByUserID находит рекрутера по ID пользователя
+func (r *recruiterRepository) FindByUserID(userID uuid.UUID) (*models.Recruiter, error) {
+	var recruiter models.Recruiter
+	if err := r.db.Where("user_id = ?", userID).First(&recruiter).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrRecruiterNotFound
+		}
+		return nil, err
+	}
+	return &recruiter, nil
+}
+
+// ListByCompany возвращает всех рекрутеров компании
+func (r *recruiterRepository) ListByCompany(companyID uuid.UUID) ([]models.Recruiter, error) {
+	recruiters := make([]models.Recruiter, 0)
+	err := r.db.
+		Where("company_id = ?", companyID).
+		Order("created_at ASC").
+		Find(&recruiters).Error
+	if err != nil {
+		return nil, err
+	}
+	return recruiters, nil
+}
+
+// ListUserIDsByCompanyStatus возвращает ID пользователей, состоящих в компаниях с указанным состоянием проверки
+func (r *recruiterRepository) ListUserIDsByCompanyStatus(status models.VerificationStatus) ([]uuid.UUID, error) {
+	userIDs := make([]uuid.UUID, 0)
+	err := r.db.Model(&models.Recruiter{}).
+		Joins("JOIN companies ON companies.id = recruiters.company_id").
+		Where("companies.verification_status = ?", status).
+		Pluck("recruiters.user_id", &userIDs).Error
+	if err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+// Delete удаляет пользователя из компании
+func (r *recruiterRepository) Delete(userID uuid.UUID) error {
+	result := r.db.Delete(&models.Recruiter{}, "user_id = ?", userID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRecruiterNotFound
+	}
+	return nil
+}
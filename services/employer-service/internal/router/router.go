@@ -0,0 +1,118 @@
+package router
+
+import (
+	"employer-service/internal/dto"
+	"employer-service/internal/handler"
+	"net/http"
+	"observability/health"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Роли пользователей, которым доступна проверка компаний
+var reviewerRoles = []string{"university", "admin"}
+
+// SetupRouter настраивает и возвращает роутер Gin
+func SetupRouter(employerHandler *handler.EmployerHandler, healthHandler *health.Handler) *gin.Engine {
+	// Создание роутера с стандартными middleware (Logger и Recovery)
+	r := gin.Default()
+
+	// Группа API маршрутов (пользователь аутентифицирован API Gateway)
+	api := r.Group("/api/employers")
+	api.Use(userMiddleware())
+	{
+		// Профиль компании
+		companies := api.Group("/companies")
+		{
+			companies.GET("/:id", employerHandler.GetCompany)
+
+			// Своя компания и её рекрутеры (только работодатели)
+			own := companies.Group("")
+			own.Use(requireRoles("employer"))
+			{
+				own.POST("", employerHandler.CreateCompany)
+				own.GET("/my", employerHandler.GetMyCompany)
+				own.PUT("/my", employerHandler.UpdateMyCompany)
+				own.GET("/my/recruiters", employerHandler.ListRecruiters)
+				own.POST("/my/recruiters", employerHandler.InviteRecruiter)
+				own.DELETE("/my/recruiters/:userId", employerHandler.RemoveRecruiter)
+				own.GET("/my/invitations", employerHandler.ListRecruiterInvitations)
+				own.DELETE("/my/invitations/:userId", employerHandler.CancelRecruiterInvitation)
+			}
+		}
+
+		// Приглашения стать рекрутером: принять их может только сам приглашённый работодатель
+		invitations := api.Group("/invitations")
+		invitations.Use(requireRoles("employer"))
+		{
+			invitations.GET("", employerHandler.ListMyInvitations)
+			invitations.POST("/:companyId/accept", employerHandler.AcceptInvitation)
+			invitations.POST("/:companyId/decline", employerHandler.DeclineInvitation)
+		}
+
+		// Проверка БИН (только университеты и администраторы)
+		verifications := api.Group("/verifications")
+		verifications.Use(requireRoles(reviewerRoles...))
+		{
+			verifications.GET("", employerHandler.ListVerifications)
+			verifications.POST("/:id/verify", employerHandler.Verify)
+			verifications.POST("/:id/reject", employerHandler.Reject)
+		}
+	}
+
+	// Внутренние маршруты для других сервисов (не проксируются API Gateway)
+	internal := r.Group("/internal")
+	{
+		internal.GET("/recruiters/verified", employerHandler.ListVerifiedRecruiters)
+		internal.GET("/recruiters/:userId/verification", employerHandler.GetVerification)
+	}
+
+	// Проверки состояния: живость процесса и готовность (база данных доступна, сервер не останавливается).
+	// /health оставлен для совместимости и равносилен /health/ready
+	r.GET("/health", healthHandler.Ready)
+	r.GET("/health/live", healthHandler.Live)
+	r.GET("/health/ready", healthHandler.Ready)
+
+	return r
+}
+
+// userMiddleware извлекает ID, роль и email пользователя из заголовков X-User-ID, X-User-Role и X-User-Email,
+// которые API Gateway устанавливает после проверки JWT токена
+func userMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := uuid.Parse(c.GetHeader("X-User-ID"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
+				Error:   "Не авторизован",
+				Message: "Отсутствует или некорректен заголовок X-User-ID",
+			})
+			return
+		}
+
+		// Сохранение данных пользователя в контексте запроса
+		c.Set("user_id", userID)
+		c.Set("user_role", c.GetHeader("X-User-Role"))
+		c.Set("user_email", c.GetHeader("X-User-Email"))
+
+		c.Next()
+	}
+}
+
+// requireRoles пропускает только пользователей с одной из указанных ролей
+func requireRoles(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := c.GetString("user_role")
+		for _, allowed := range roles {
+			if role == allowed {
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "Доступ запрещён",
+			Message: "Недостаточно прав для выполнения операции",
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"employer-service/internal/dto"
+	"employer-service/internal/models"
+	"employer-service/internal/repository"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// defaultPageSize - размер страницы по умолчанию
+const defaultPageSize = 20
+
+// Ошибки сервиса работодателей
+var (
+	ErrNotCompanyOwner               = errors.New("действие доступно только владельцу компании")
+	ErrOwnerRemoval                  = errors.New("владельца нельзя удалить из компании")
+	ErrSelfInvitation                = errors.New("владелец уже состоит в компании")
+	ErrInvalidVerificationTransition = errors.New("недопустимый переход состояния проверки")
+)
+
+// EmployerService определяет интерфейс сервиса работодателей
+type EmployerService interface {
+	CreateCompany(userID uuid.UUID, req *dto.CompanyRequest) (*models.Company, error)
+	GetMyCompany(userID uuid.UUID) (*models.Company, error)
+	UpdateMyCompany(userID uuid.UUID, req *dto.CompanyRequest) (*models.Company, error)
+	GetCompany(companyID uuid.UUID) (*models.Company, error)
+	ListRecruiters(userID uuid.UUID) ([]models.Recruiter, error)
+	InviteRecruiter(userID uuid.UUID, req *dto.AddRecruiterRequest) (*models.RecruiterInvitation, error)
+	ListRecruiterInvitations(userID uuid.UUID) ([]models.RecruiterInvitation, error)
+	CancelRecruiterInvitation(userID, invitedUserID uuid.UUID) error
+	ListMyInvitations(userID uuid.UUID) ([]models.RecruiterInvitation, error)
+	AcceptInvitation(userID, companyID uuid.UUID) (*models.Recruiter, error)
+	DeclineInvitation(userID, companyID uuid.UUID) error
+	RemoveRecruiter(userID, recruiterUserID uuid.UUID) error
+	ListCompanies(query *dto.ListCompaniesQuery) (*dto.CompanyListResponse, error)
+	Verify(reviewerID, companyID uuid.UUID) (*models.Company, error)
+	Reject(reviewerID, companyID uuid.UUID, reason string) (*models.Company, error)
+	GetVerification(userID uuid.UUID) (*dto.VerificationResponse, error)
+	ListVerifiedRecruiters() (*dto.VerifiedRecruitersResponse, error)
+}
+
+// employerService реализует EmployerService
+type employerService struct {
+	companyRepo    repository.CompanyRepository
+	recruiterRepo  repository.RecruiterRepository
+	invitationRepo repository.InvitationRepository
+}
+
+// NewEmployerService создаёт новый экземпляр сервиса работодателей
+func NewEmployerService(companyRepo repository.CompanyRepository, recruiterRepo repository.RecruiterRepository, invitationRepo repository.InvitationRepository) EmployerService {
+	return &employerService{
+		companyRepo:    companyRepo,
+		recruiterRepo:  recruiterRepo,
+		invitationRepo: invitationRepo,
+	}
+}
+
+// CreateCompany регистрирует компанию; создатель становится её владельцем
+func (s *employerService) CreateCompany(userID uuid.UUID, req *dto.CompanyRequest) (*models.Company, error) {
+	// Пользователь может состоять только в одной компании
+	_, err := s.recruiterRepo.FindByUserID(userID)
+	if err == nil {
+		return nil, repository.ErrRecruiterAlreadyExists
+	}
+	if !errors.Is(err, repository.ErrRecruiterNotFound) {
+		return nil, err
+	}
+
+	company := &models.Company{
+		VerificationStatus: models.VerificationPending,
+	}
+	applyCompanyRequest(company, req)
+
+	owner := &models.Recruiter{
+		UserID: userID,
+		Role:   models.RecruiterOwner,
+	}
+	if err := s.companyRepo.CreateWithOwner(company, owner); err != nil {
+		return nil, err
+	}
+	return company, nil
+}
+
+// GetMyCompany возвращает компанию, в которой состоит пользователь
+func (s *employerService) GetMyCompany(userID uuid.UUID) (*models.Company, error) {
+	recruiter, err := s.recruiterRepo.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.companyRepo.FindByID(recruiter.CompanyID)
+}
+
+// UpdateMyCompany обновляет профиль компании владельца.
+// Изменение БИН или наименования, а также правка отклонённой заявки
+// возвращают компанию на повторную проверку.
+func (s *employerService) UpdateMyCompany(userID uuid.UUID, req *dto.CompanyRequest) (*models.Company, error) {
+	recruiter, err := s.findOwner(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	company, err := s.companyRepo.FindByID(recruiter.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	identityChanged := company.BIN != req.BIN || company.LegalName != strings.TrimSpace(req.LegalName)
+	applyCompanyRequest(company, req)
+
+	if identityChanged || company.VerificationStatus == models.VerificationRejected {
+		company.VerificationStatus = models.VerificationPending
+		company.RejectionReason = ""
+		company.ReviewedBy = nil
+		company.ReviewedAt = nil
+	}
+
+	if err := s.companyRepo.Update(company); err != nil {
+		return nil, err
+	}
+	return company, nil
+}
+
+// GetCompany возвращает публичный профиль компании
+func (s *employerService) GetCompany(companyID uuid.UUID) (*models.Company, error) {
+	return s.companyRepo.FindByID(companyID)
+}
+
+// ListRecruiters возвращает рекрутеров компании пользователя
+func (s *employerService) ListRecruiters(userID uuid.UUID) ([]models.Recruiter, error) {
+	recruiter, err := s.recruiterRepo.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.recruiterRepo.ListByCompany(recruiter.CompanyID)
+}
+
+// InviteRecruiter приглашает пользователя в компанию владельца. Рекрутером пользователь
+// становится, только приняв приглашение под своей учётной записью работодателя
+func (s *employerService) InviteRecruiter(userID uuid.UUID, req *dto.AddRecruiterRequest) (*models.RecruiterInvitation, error) {
+	owner, err := s.findOwner(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitedUserID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if invitedUserID == owner.UserID {
+		return nil, ErrSelfInvitation
+	}
+
+	// Состоящего в компании пользователя приглашать бессмысленно: принять приглашение он не сможет
+	_, err = s.recruiterRepo.FindByUserID(invitedUserID)
+	if err == nil {
+		return nil, repository.ErrRecruiterAlreadyExists
+	}
+	if !errors.Is(err, repository.ErrRecruiterNotFound) {
+		return nil, err
+	}
+
+	invitation := &models.RecruiterInvitation{
+		CompanyID: owner.CompanyID,
+		UserID:    invitedUserID,
+		InvitedBy: owner.UserID,
+	}
+	if err := s.invitationRepo.Create(invitation); err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+// ListRecruiterInvitations возвращает приглашения компании владельца, ожидающие ответа
+func (s *employerService) ListRecruiterInvitations(userID uuid.UUID) ([]models.RecruiterInvitation, error) {
+	owner, err := s.findOwner(userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.invitationRepo.ListByCompany(owner.CompanyID)
+}
+
+// CancelRecruiterInvitation отзывает приглашение компании владельца
+func (s *employerService) CancelRecruiterInvitation(userID, invitedUserID uuid.UUID) error {
+	owner, err := s.findOwner(userID)
+	if err != nil {
+		return err
+	}
+	return s.invitationRepo.Delete(owner.CompanyID, invitedUserID)
+}
+
+// ListMyInvitations возвращает приглашения текущего пользователя
+func (s *employerService) ListMyInvitations(userID uuid.UUID) ([]models.RecruiterInvitation, error) {
+	return s.invitationRepo.ListByUser(userID)
+}
+
+// AcceptInvitation добавляет пользователя в пригласившую компанию рекрутером.
+// Остальные приглашения пользователя удаляются: состоять можно только в одной компании
+func (s *employerService) AcceptInvitation(userID, companyID uuid.UUID) (*models.Recruiter, error) {
+	invitation, err := s.invitationRepo.Find(companyID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	recruiter := &models.Recruiter{
+		UserID:    userID,
+		CompanyID: invitation.CompanyID,
+		Role:      models.RecruiterMember,
+	}
+	if err := s.invitationRepo.Accept(invitation, recruiter); err != nil {
+		return nil, err
+	}
+	return recruiter, nil
+}
+
+// DeclineInvitation отклоняет приглашение в компанию
+func (s *employerService) DeclineInvitation(userID, companyID uuid.UUID) error {
+	return s.invitationRepo.Delete(companyID, userID)
+}
+
+// RemoveRecruiter удаляет рекрутера из компании владельца
+func (s *employerService) RemoveRecruiter(userID, recruiterUserID uuid.UUID) error {
+	owner, err := s.findOwner(userID)
+	if err != nil {
+		return err
+	}
+	if recruiterUserID == owner.UserID {
+		return ErrOwnerRemoval
+	}
+
+	recruiter, err := s.recruiterRepo.FindByUserID(recruiterUserID)
+	if err != nil {
+		return err
+	}
+	// Рекрутер другой компании для владельца не существует
+	if recruiter.CompanyID != owner.CompanyID {
+		return repository.ErrRecruiterNotFound
+	}
+
+	return s.recruiterRepo.Delete(recruiterUserID)
+}
+
+// ListCompanies возвращает компании для проверки с фильтром по состоянию
+func (s *employerService) ListCompanies(query *dto.ListCompaniesQuery) (*dto.CompanyListResponse, error) {
+	page, pageSize := query.Page, query.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+
+	companies, total, err := s.companyRepo.List(query.Status, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CompanyListResponse{
+		Items:    companies,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// Verify подтверждает компанию
+func (s *employerService) Verify(reviewerID, companyID uuid.UUID) (*models.Company, error) {
+	return s.review(reviewerID, companyID, models.VerificationVerified, "")
+}
+
+// Reject отклоняет компанию или отзывает ранее выданное подтверждение
+func (s *employerService) Reject(reviewerID, companyID uuid.UUID, reason string) (*models.Company, error) {
+	return s.review(reviewerID, companyID, models.VerificationRejected, strings.TrimSpace(reason))
+}
+
+// GetVerification возвращает состояние проверки компании, в которой состоит пользователь
+func (s *employerService) GetVerification(userID uuid.UUID) (*dto.VerificationResponse, error) {
+	company, err := s.GetMyCompany(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.VerificationResponse{
+		UserID:             userID.String(),
+		CompanyID:          company.ID.String(),
+		VerificationStatus: company.VerificationStatus,
+		Verified:           company.VerificationStatus == models.VerificationVerified,
+	}, nil
+}
+
+// ListVerifiedRecruiters возвращает пользователей, состоящих в подтверждённых компаниях
+func (s *employerService) ListVerifiedRecruiters() (*dto.VerifiedRecruitersResponse, error) {
+	userIDs, err := s.recruiterRepo.ListUserIDsByCompanyStatus(models.VerificationVerified)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.VerifiedRecruitersResponse{UserIDs: userIDs}, nil
+}
+
+// review переводит компанию в новое состояние проверки
+func (s *employerService) review(reviewerID, companyID uuid.UUID, next models.VerificationStatus, reason string) (*models.Company, error) {
+	company, err := s.companyRepo.FindByID(companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !company.VerificationStatus.CanTransitionTo(next) {
+		return nil, ErrInvalidVerificationTransition
+	}
+
+	now := time.Now()
+	company.VerificationStatus = next
+	company.RejectionReason = reason
+	company.ReviewedBy = &reviewerID
+	company.ReviewedAt = &now
+
+	if err := s.companyRepo.Update(company); err != nil {
+		return nil, err
+	}
+	return company, nil
+}
+
+// findOwner проверяет, что пользователь является владельцем компании
+func (s *employerService) findOwner(userID uuid.UUID) (*models.Recruiter, error) {
+	recruiter, err := s.recruiterRepo.FindByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if recruiter.Role != models.RecruiterOwner {
+		return nil, ErrNotCompanyOwner
+	}
+	return recruiter, nil
+}
+
+// applyCompanyRequest переносит поля запроса в модель компании
+func applyCompanyRequest(company *models.Company, req *dto.CompanyRequest) {
+	company.BIN = req.BIN
+	company.LegalName = strings.TrimSpace(req.LegalName)
+	company.ContactEmail = req.ContactEmail
+	company.ContactPhone = req.ContactPhone
+	company.Industry = strings.TrimSpace(req.Industry)
+	company.Size = req.Size
+	company.LogoURL = req.LogoURL
+}
@@ -1 +1,65 @@
-package cmd
+package main
+
+import (
+	"log"
+	"observability/health"
+	"observability/server"
+	"student-service/internal/config"
+	"student-service/internal/handler"
+	"student-service/internal/repository"
+	"student-service/internal/router"
+	"student-service/internal/service"
+)
+
+// @title Student Service API
+// @version 1.0
+// @description Сервис студентов для системы трудоустройства студентов
+// @host localhost:8082
+// @BasePath /api
+
+func main() {
+	// Загрузка конфигурации из переменных окружения
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Ошибка загрузки конфигурации: %v", err)
+	}
+
+	// Подключение к базе данных PostgreSQL
+	db, err := config.ConnectDatabase(cfg)
+	if err != nil {
+		log.Fatalf("Ошибка подключения к базе данных: %v", err)
+	}
+
+	// Инициализация слоёв приложения
+	profileRepo := repository.NewProfileRepository(db)
+	resumeRepo := repository.NewResumeRepository(db)
+	studentService := service.NewStudentService(profileRepo, resumeRepo)
+	studentHandler := handler.NewStudentHandler(studentService)
+
+	// Пул соединений с БД проверяется в /health/ready и закрывается после завершения обрабатываемых запросов
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("Ошибка получения пула соединений с базой данных: %v", err)
+	}
+	readiness := server.NewReadiness()
+	healthHandler := health.NewHandler("student-service", sqlDB, readiness, cfg.HealthCheckTimeout)
+
+	// Создание и настройка роутера
+	r := router.SetupRouter(studentHandler, healthHandler)
+
+	// Запуск HTTP сервера (до SIGINT/SIGTERM)
+	log.Printf("Student Service запущен на порту %s", cfg.ServerPort)
+	err = server.Run(r, server.Config{
+		Addr:              ":" + cfg.ServerPort,
+		ReadTimeout:       cfg.ServerReadTimeout,
+		ReadHeaderTimeout: cfg.ServerReadHeaderTimeout,
+		WriteTimeout:      cfg.ServerWriteTimeout,
+		IdleTimeout:       cfg.ServerIdleTimeout,
+		ShutdownDelay:     cfg.ShutdownDelay,
+		ShutdownTimeout:   cfg.ShutdownTimeout,
+	}, readiness, sqlDB.Close)
+	if err != nil {
+		log.Fatalf("Ошибка работы сервера: %v", err)
+	}
+	log.Printf("Student Service остановлен")
+}
@@ -0,0 +1,56 @@
+package dto
+
+import "student-service/internal/models"
+
+// ProfileRequest представляет запрос на заполнение профиля студента
+type ProfileRequest struct {
+	IIN            string `json:"iin" binding:"required,len=12,numeric" example:"040512500123"`
+	LastName       string `json:"lastName" binding:"required,max=100" example:"Иванов"`
+	FirstName      string `json:"firstName" binding:"required,max=100" example:"Иван"`
+	MiddleName     string `json:"middleName" binding:"max=100" example:"Иванович"`
+	BirthDate      string `json:"birthDate" binding:"required,datetime=2006-01-02" example:"2004-05-12"`
+	Phone          string `json:"phone" binding:"required,e164" example:"+77011234567"`
+	University     string `json:"university" binding:"required,max=255" example:"КазНУ им. аль-Фараби"`
+	Faculty        string `json:"faculty" binding:"max=255" example:"Факультет информационных технологий"`
+	GraduationYear int    `json:"graduationYear" binding:"required,min=1950,max=2100" example:"2026"`
+}
+
+// ResumeRequest представляет запрос на создание или полную замену резюме
+type ResumeRequest struct {
+	Title      string              `json:"title" binding:"required,max=255" example:"Junior Go разработчик"`
+	Summary    string              `json:"summary" example:"Студент 4 курса, интересуюсь backend-разработкой"`
+	Education  []EducationRequest  `json:"education" binding:"max=20,dive"`
+	Experience []ExperienceRequest `json:"experience" binding:"max=50,dive"`
+	Skills     []SkillRequest      `json:"skills" binding:"max=100,dive"`
+	Languages  []LanguageRequest   `json:"languages" binding:"max=20,dive"`
+}
+
+// EducationRequest представляет запись об образовании в резюме
+type EducationRequest struct {
+	Institution  string `json:"institution" binding:"required,max=255" example:"КазНУ им. аль-Фараби"`
+	Degree       string `json:"degree" binding:"max=100" example:"Бакалавр"`
+	FieldOfStudy string `json:"fieldOfStudy" binding:"max=255" example:"Информационные системы"`
+	StartYear    int    `json:"startYear" binding:"required,min=1950,max=2100" example:"2022"`
+	EndYear      *int   `json:"endYear" binding:"omitempty,min=1950,max=2100,gtefield=StartYear" example:"2026"`
+}
+
+// ExperienceRequest представляет запись об опыте работы в резюме
+type ExperienceRequest struct {
+	Company     string `json:"company" binding:"required,max=255" example:"ТОО Компания"`
+	JobTitle    string `json:"jobTitle" binding:"required,max=255" example:"Стажёр-разработчик"`
+	StartDate   string `json:"startDate" binding:"required,datetime=2006-01" example:"2025-06"`
+	EndDate     string `json:"endDate" binding:"omitempty,datetime=2006-01" example:"2025-08"`
+	Description string `json:"description" example:"Разработка REST API"`
+}
+
+// SkillRequest представляет навык в резюме
+type SkillRequest struct {
+	Name  string            `json:"name" binding:"required,max=100" example:"Go"`
+	Level models.SkillLevel `json:"level" binding:"omitempty,oneof=beginner intermediate advanced expert" example:"intermediate"`
+}
+
+// LanguageRequest представляет владение языком в резюме
+type LanguageRequest struct {
+	Name  string               `json:"name" binding:"required,max=50" example:"Английский"`
+	Level models.LanguageLevel `json:"level" binding:"required,oneof=A1 A2 B1 B2 C1 C2 native" example:"B2"`
+}
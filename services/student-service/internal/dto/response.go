@@ -0,0 +1,13 @@
+package dto
+
+// ErrorResponse представляет ответ с ошибкой
+type ErrorResponse struct {
+	Error   string            `json:"error" example:"Ошибка валидации"`
+	Message string            `json:"message" example:"Резюме не найдено"`
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// SuccessResponse представляет успешный ответ без данных
+type SuccessResponse struct {
+	Message string `json:"message" example:"Операция выполнена успешно"`
+}
@@ -0,0 +1,321 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"student-service/internal/dto"
+	"student-service/internal/repository"
+	"student-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// StudentHandler обрабатывает HTTP запросы профиля и резюме студента
+type StudentHandler struct {
+	studentService service.StudentService
+}
+
+// NewStudentHandler создаёт новый экземпляр обработчика студентов
+func NewStudentHandler(studentService service.StudentService) *StudentHandler {
+	return &StudentHandler{
+		studentService: studentService,
+	}
+}
+
+// GetProfile возвращает профиль текущего студента
+// @Summary Получение профиля студента
+// @Description Возвращает профиль текущего студента
+// @Tags students
+// @Produce json
+// @Success 200 {object} models.StudentProfile
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /students/me [get]
+func (h *StudentHandler) GetProfile(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	profile, err := h.studentService.GetProfile(userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, profile)
+}
+
+// SaveProfile создаёт или обновляет профиль текущего студента
+// @Summary Заполнение профиля студента
+// @Description Создаёт или обновляет профиль текущего студента
+// @Tags students
+// @Accept json
+// @Produce json
+// @Param request body dto.ProfileRequest true "Данные профиля"
+// @Success 200 {object} models.StudentProfile
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /students/me [put]
+func (h *StudentHandler) SaveProfile(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ProfileRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	profile, err := h.studentService.SaveProfile(userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, profile)
+}
+
+// ListResumes возвращает резюме текущего студента
+// @Summary Список резюме
+// @Description Возвращает все резюме текущего студента со всеми разделами
+// @Tags resumes
+// @Produce json
+// @Success 200 {array} models.Resume
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /students/me/resumes [get]
+func (h *StudentHandler) ListResumes(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	resumes, err := h.studentService.ListResumes(userID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resumes)
+}
+
+// GetResume возвращает резюме по ID
+// @Summary Получение резюме
+// @Description Возвращает резюме текущего студента
+// @Tags resumes
+// @Produce json
+// @Param id path string true "ID резюме"
+// @Success 200 {object} models.Resume
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /students/me/resumes/{id} [get]
+func (h *StudentHandler) GetResume(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	resumeID, ok := resumeIDParam(c)
+	if !ok {
+		return
+	}
+
+	resume, err := h.studentService.GetResume(userID, resumeID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resume)
+}
+
+// CreateResume создаёт новое резюме
+// @Summary Создание резюме
+// @Description Создаёт резюме текущего студента с разделами образования, опыта, навыков и языков
+// @Tags resumes
+// @Accept json
+// @Produce json
+// @Param request body dto.ResumeRequest true "Данные резюме"
+// @Success 201 {object} models.Resume
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /students/me/resumes [post]
+func (h *StudentHandler) CreateResume(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ResumeRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	resume, err := h.studentService.CreateResume(userID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resume)
+}
+
+// UpdateResume заменяет содержимое резюме
+// @Summary Обновление резюме
+// @Description Полностью заменяет содержимое и разделы резюме текущего студента
+// @Tags resumes
+// @Accept json
+// @Produce json
+// @Param id path string true "ID резюме"
+// @Param request body dto.ResumeRequest true "Данные резюме"
+// @Success 200 {object} models.Resume
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /students/me/resumes/{id} [put]
+func (h *StudentHandler) UpdateResume(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	resumeID, ok := resumeIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ResumeRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	resume, err := h.studentService.UpdateResume(userID, resumeID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resume)
+}
+
+// DeleteResume удаляет резюме
+// @Summary Удаление резюме
+// @Description Удаляет резюме текущего студента
+// @Tags resumes
+// @Produce json
+// @Param id path string true "ID резюме"
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /students/me/resumes/{id} [delete]
+func (h *StudentHandler) DeleteResume(c *gin.Context) {
+	userID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	resumeID, ok := resumeIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.studentService.DeleteResume(userID, resumeID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{Message: "Резюме удалено"})
+}
+
+// GetResumeInternal возвращает резюме по ID без проверки владельца.
+// Внутренний эндпоинт для других сервисов, не проксируется API Gateway.
+func (h *StudentHandler) GetResumeInternal(c *gin.Context) {
+	resumeID, ok := resumeIDParam(c)
+	if !ok {
+		return
+	}
+
+	resume, err := h.studentService.GetResumeByID(resumeID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resume)
+}
+
+// bindJSON парсит и валидирует тело запроса
+func bindJSON(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return false
+	}
+	return true
+}
+
+// currentUserID извлекает ID пользователя из контекста (установлен middleware).
+// При ошибке ответ уже отправлен клиенту и возвращается false.
+func currentUserID(c *gin.Context) (uuid.UUID, bool) {
+	userID, exists := c.Get("user_id")
+	id, ok := userID.(uuid.UUID)
+	if !exists || !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "Не авторизован",
+			Message: "Требуется аутентификация",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// resumeIDParam извлекает ID резюме из пути запроса
+func resumeIDParam(c *gin.Context) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: "Некорректный ID резюме",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// handleServiceError обрабатывает ошибки сервиса и возвращает соответствующий HTTP ответ
+func handleServiceError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrProfileNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Не найдено",
+			Message: "Профиль студента не заполнен",
+		})
+	case errors.Is(err, repository.ErrResumeNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Не найдено",
+			Message: "Резюме не найдено",
+		})
+	case errors.Is(err, repository.ErrIINAlreadyExists):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Студент с таким ИИН уже существует",
+		})
+	case errors.Is(err, service.ErrNotResumeOwner):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "Доступ запрещён",
+			Message: "Резюме принадлежит другому студенту",
+		})
+	case errors.Is(err, service.ErrInvalidPeriod):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: "Дата окончания раньше даты начала",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Внутренняя ошибка сервера",
+			Message: "Произошла непредвиденная ошибка",
+		})
+	}
+}
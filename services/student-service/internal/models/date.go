@@ -0,0 +1,83 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DateLayout - формат календарной даты в API (input type="date" на клиенте)
+const DateLayout = "2006-01-02"
+
+// Date - календарная дата без времени и часового пояса.
+// В JSON передаётся строкой ГГГГ-ММ-ДД, в БД хранится в колонке типа date.
+type Date struct {
+	time.Time
+}
+
+// ParseDate разбирает дату в формате ГГГГ-ММ-ДД
+func ParseDate(value string) (Date, error) {
+	t, err := time.Parse(DateLayout, value)
+	if err != nil {
+		return Date{}, err
+	}
+	return Date{Time: t}, nil
+}
+
+// String возвращает дату в формате ГГГГ-ММ-ДД
+func (d Date) String() string {
+	return d.Format(DateLayout)
+}
+
+// MarshalJSON реализует json.Marshaler
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON реализует json.Unmarshaler
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := ParseDate(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Value реализует driver.Valuer
+func (d Date) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan реализует sql.Scanner
+func (d *Date) Scan(src interface{}) error {
+	switch value := src.(type) {
+	case time.Time:
+		*d = Date{Time: time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, time.UTC)}
+		return nil
+	case string:
+		return d.scanString(value)
+	case []byte:
+		return d.scanString(string(value))
+	default:
+		return fmt.Errorf("неподдерживаемый тип даты %T", src)
+	}
+}
+
+// scanString разбирает дату, полученную из БД строкой
+func (d *Date) scanString(value string) error {
+	if len(value) > len(DateLayout) {
+		value = value[:len(DateLayout)]
+	}
+	parsed, err := ParseDate(value)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
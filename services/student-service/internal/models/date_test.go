@@ -0,0 +1,61 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDateJSON(t *testing.T) {
+	var profile struct {
+		BirthDate Date `json:"birthDate"`
+	}
+	if err := json.Unmarshal([]byte(`{"birthDate":"2004-05-12"}`), &profile); err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2004, time.May, 12, 0, 0, 0, 0, time.UTC); !profile.BirthDate.Equal(want) {
+		t.Errorf("BirthDate = %v, want %v", profile.BirthDate, want)
+	}
+
+	data, err := json.Marshal(profile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), `{"birthDate":"2004-05-12"}`; got != want {
+		t.Errorf("Marshal = %s, want %s", got, want)
+	}
+
+	for _, invalid := range []string{`"2004-05-12T00:00:00Z"`, `"12.05.2004"`, `"2004-13-01"`, `20040512`} {
+		var date Date
+		if err := json.Unmarshal([]byte(invalid), &date); err == nil {
+			t.Errorf("Unmarshal(%s) = %v, want error", invalid, date)
+		}
+	}
+}
+
+func TestDateScan(t *testing.T) {
+	tests := []struct {
+		name string
+		src  interface{}
+	}{
+		{"time with zone", time.Date(2004, time.May, 12, 0, 0, 0, 0, time.FixedZone("ALMT", 5*3600))},
+		{"string", "2004-05-12"},
+		{"timestamp string", "2004-05-12 00:00:00+00"},
+		{"bytes", []byte("2004-05-12")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var date Date
+			if err := date.Scan(tt.src); err != nil {
+				t.Fatal(err)
+			}
+			if got := date.String(); got != "2004-05-12" {
+				t.Errorf("Scan(%v) = %s, want 2004-05-12", tt.src, got)
+			}
+			if value, _ := date.Value(); value != "2004-05-12" {
+				t.Errorf("Value = %v, want 2004-05-12", value)
+			}
+		})
+	}
+}
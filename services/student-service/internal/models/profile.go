@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StudentProfile содержит персональные и учебные данные студента.
+// Первичный ключ совпадает с ID пользователя в auth-service.
+type StudentProfile struct {
+	UserID         uuid.UUID `gorm:"type:uuid;primary_key" json:"userId"`
+	IIN            string    `gorm:"type:varchar(12);uniqueIndex:idx_students_iin;not null" json:"iin"`
+	LastName       string    `gorm:"type:varchar(100);not null" json:"lastName"`
+	FirstName      string    `gorm:"type:varchar(100);not null" json:"firstName"`
+	MiddleName     string    `gorm:"type:varchar(100)" json:"middleName"`
+	BirthDate      Date      `gorm:"type:date;not null" json:"birthDate"`
+	Phone          string    `gorm:"type:varchar(20);not null" json:"phone"`
+	University     string    `gorm:"type:varchar(255);not null" json:"university"`
+	Faculty        string    `gorm:"type:varchar(255)" json:"faculty"`
+	GraduationYear int       `gorm:"not null" json:"graduationYear"`
+	CreatedAt      time.Time `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt      time.Time `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName возвращает имя таблицы для модели StudentProfile
+func (StudentProfile) TableName() string {
+	return "students"
+}
@@ -0,0 +1,121 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SkillLevel определяет уровень владения навыком
+type SkillLevel string
+
+const (
+	SkillBeginner     SkillLevel = "beginner"     // Начальный
+	SkillIntermediate SkillLevel = "intermediate" // Средний
+	SkillAdvanced     SkillLevel = "advanced"     // Продвинутый
+	SkillExpert       SkillLevel = "expert"       // Эксперт
+)
+
+// LanguageLevel определяет уровень владения языком по шкале CEFR
+type LanguageLevel string
+
+const (
+	LanguageA1     LanguageLevel = "A1"
+	LanguageA2     LanguageLevel = "A2"
+	LanguageB1     LanguageLevel = "B1"
+	LanguageB2     LanguageLevel = "B2"
+	LanguageC1     LanguageLevel = "C1"
+	LanguageC2     LanguageLevel = "C2"
+	LanguageNative LanguageLevel = "native" // Родной язык
+)
+
+// Resume представляет резюме студента со структурированными разделами
+type Resume struct {
+	ID         uuid.UUID          `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	StudentID  uuid.UUID          `gorm:"type:uuid;index;not null" json:"studentId"`
+	Title      string             `gorm:"type:varchar(255);not null" json:"title"`
+	Summary    string             `gorm:"type:text" json:"summary"`
+	Education  []ResumeEducation  `gorm:"foreignKey:ResumeID;constraint:OnDelete:CASCADE" json:"education"`
+	Experience []ResumeExperience `gorm:"foreignKey:ResumeID;constraint:OnDelete:CASCADE" json:"experience"`
+	Skills     []ResumeSkill      `gorm:"foreignKey:ResumeID;constraint:OnDelete:CASCADE" json:"skills"`
+	Languages  []ResumeLanguage   `gorm:"foreignKey:ResumeID;constraint:OnDelete:CASCADE" json:"languages"`
+	CreatedAt  time.Time          `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt  time.Time          `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName возвращает имя таблицы для модели Resume
+func (Resume) TableName() string {
+	return "resumes"
+}
+
+// BeforeCreate выполняется перед созданием записи
+func (r *Resume) BeforeCreate(tx *gorm.DB) error {
+	// Генерация UUID если не задан
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// ResumeEducation представляет запись об образовании
+type ResumeEducation struct {
+	ID           uint      `gorm:"primaryKey" json:"-"`
+	ResumeID     uuid.UUID `gorm:"type:uuid;index;not null" json:"-"`
+	Position     int       `gorm:"not null" json:"-"` // Порядок отображения в резюме
+	Institution  string    `gorm:"type:varchar(255);not null" json:"institution"`
+	Degree       string    `gorm:"type:varchar(100)" json:"degree"`
+	FieldOfStudy string    `gorm:"type:varchar(255)" json:"fieldOfStudy"`
+	StartYear    int       `gorm:"not null" json:"startYear"`
+	EndYear      *int      `json:"endYear"` // nil - обучение продолжается
+}
+
+// TableName возвращает имя таблицы для модели ResumeEducation
+func (ResumeEducation) TableName() string {
+	return "resume_education"
+}
+
+// ResumeExperience представляет запись об опыте работы
+type ResumeExperience struct {
+	ID          uint      `gorm:"primaryKey" json:"-"`
+	ResumeID    uuid.UUID `gorm:"type:uuid;index;not null" json:"-"`
+	Position    int       `gorm:"not null" json:"-"` // Порядок отображения в резюме
+	Company     string    `gorm:"type:varchar(255);not null" json:"company"`
+	JobTitle    string    `gorm:"type:varchar(255);not null" json:"jobTitle"`
+	StartDate   string    `gorm:"type:varchar(7);not null" json:"startDate"` // Формат ГГГГ-ММ
+	EndDate     string    `gorm:"type:varchar(7)" json:"endDate"`            // Пусто - по настоящее время
+	Description string    `gorm:"type:text" json:"description"`
+}
+
+// TableName возвращает имя таблицы для модели ResumeExperience
+func (ResumeExperience) TableName() string {
+	return "resume_experience"
+}
+
+// ResumeSkill представляет навык
+type ResumeSkill struct {
+	ID       uint       `gorm:"primaryKey" json:"-"`
+	ResumeID uuid.UUID  `gorm:"type:uuid;index;not null" json:"-"`
+	Position int        `gorm:"not null" json:"-"` // Порядок отображения в резюме
+	Name     string     `gorm:"type:varchar(100);not null" json:"name"`
+	Level    SkillLevel `gorm:"type:varchar(16)" json:"level"`
+}
+
+// TableName возвращает имя таблицы для модели ResumeSkill
+func (ResumeSkill) TableName() string {
+	return "resume_skills"
+}
+
+// ResumeLanguage представляет владение иностранным языком
+type ResumeLanguage struct {
+	ID       uint          `gorm:"primaryKey" json:"-"`
+	ResumeID uuid.UUID     `gorm:"type:uuid;index;not null" json:"-"`
+	Position int           `gorm:"not null" json:"-"` // Порядок отображения в резюме
+	Name     string        `gorm:"type:varchar(50);not null" json:"name"`
+	Level    LanguageLevel `gorm:"type:varchar(8);not null" json:"level"`
+}
+
+// TableName возвращает имя таблицы для модели ResumeLanguage
+func (ResumeLanguage) TableName() string {
+	return "resume_languages"
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"errors"
+	"student-service/internal/models"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// Ошибки репозитория профилей
+var (
+	ErrProfileNotFound  = errors.New("профиль студента не найден")
+	ErrIINAlreadyExists = errors.New("студент с таким ИИН уже существует")
+)
+
+const (
+	iinConstraint   = "idx_students_iin" // Уникальный индекс ИИН в таблице students
+	uniqueViolation = "23505"            // SQLSTATE unique_violation
+)
+
+// ProfileRepository определяет интерфейс для работы с профилями студентов в БД
+type ProfileRepository interface {
+	FindByUserID(userID uuid.UUID) (*models.StudentProfile, error)
+	Save(profile *models.StudentProfile) error
+	ExistsByIIN(iin string, excludeUserID uuid.UUID) (bool, error)
+}
+
+// profileRepository реализует ProfileRepository
+type profileRepository struct {
+	db *gorm.DB
+}
+
+// NewProfileRepository создаёт новый экземпляр репозитория профилей
+func NewProfileRepository(db *gorm.DB) ProfileRepository {
+	return &profileRepository{db: db}
+}
+
+// FindByUserID находит профиль студента по ID пользователя
+func (r *profileRepository) FindByUserID(userID uuid.UUID) (*models.StudentProfile, error) {
+	var profile models.StudentProfile
+	if err := r.db.Where("user_id = ?", userID).First(&profile).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProfileNotFound
+		}
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// Save создаёт или обновляет профиль студента
+func (r *profileRepository) Save(profile *models.StudentProfile) error {
+	// Проверка уникальности ИИН среди других студентов
+	exists, err := r.ExistsByIIN(profile.IIN, profile.UserID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return ErrIINAlreadyExists
+	}
+
+	if err := r.db.Save(profile).Error; err != nil {
+		// Гонку при одновременной записи одного ИИН перехватывает уникальный индекс
+		if isUniqueViolation(err, iinConstraint) {
+			return ErrIINAlreadyExists
+		}
+		return err
+	}
+	return nil
+}
+
+// ExistsByIIN проверяет, занят ли ИИН другим студентом
+func (r *profileRepository) ExistsByIIN(iin string, excludeUserID uuid.UUID) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.StudentProfile{}).
+		Where("iin = ? AND user_id <> ?", iin, excludeUserID).
+		Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// isUniqueViolation проверяет, что err - нарушение уникального ограничения constraint
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == constraint
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"errors"
+	"student-service/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Ошибки репозитория резюме
+var (
+	ErrResumeNotFound = errors.New("резюме не найдено")
+)
+
+// ResumeRepository определяет интерфейс для работы с резюме в БД
+type ResumeRepository interface {
+	Create(resume *models.Resume) error
+	FindByID(id uuid.UUID) (*models.Resume, error)
+	ListByStudent(studentID uuid.UUID) ([]models.Resume, error)
+	Replace(resume *models.Resume) error
+	Delete(id uuid.UUID) error
+}
+
+// resumeRepository реализует ResumeRepository
+type resumeRepository struct {
+	db *gorm.DB
+}
+
+// NewResumeRepository создаёт новый экземпляр репозитория резюме
+func NewResumeRepository(db *gorm.DB) ResumeRepository {
+	return &resumeRepository{db: db}
+}
+
+// Create создаёт резюме вместе со всеми разделами
+func (r *resumeRepository) Create(resume *models.Resume) error {
+	return r.db.Create(resume).Error
+}
+
+// FindByID находит резюме по UUID и загружает все разделы
+func (r *resumeRepository) FindByID(id uuid.UUID) (*models.Resume, error) {
+	var resume models.Resume
+	if err := withSections(r.db).Where("id = ?", id).First(&resume).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrResumeNotFound
+		}
+		return nil, err
+	}
+	return &resume, nil
+}
+
+// ListByStudent возвращает все резюме студента с разделами
+func (r *resumeRepository) ListByStudent(studentID uuid.UUID) ([]models.Resume, error) {
+	resumes := make([]models.Resume, 0)
+	err := withSections(r.db).
+		Where("student_id = ?", studentID).
+		Order("created_at DESC").
+		Find(&resumes).Error
+	if err != nil {
+		return nil, err
+	}
+	return resumes, nil
+}
+
+// Replace обновляет резюме и полностью заменяет его разделы в одной транзакции
+func (r *resumeRepository) Replace(resume *models.Resume) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(resume).Updates(map[string]interface{}{
+			"title":   resume.Title,
+			"summary": resume.Summary,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrResumeNotFound
+		}
+
+		// Удаление старых разделов
+		sections := []interface{}{
+			&models.ResumeEducation{},
+			&models.ResumeExperience{},
+			&models.ResumeSkill{},
+			&models.ResumeLanguage{},
+		}
+		for _, section := range sections {
+			if err := tx.Where("resume_id = ?", resume.ID).Delete(section).Error; err != nil {
+				return err
+			}
+		}
+
+		// Создание новых разделов
+		if len(resume.Education) > 0 {
+			if err := tx.Create(&resume.Education).Error; err != nil {
+				return err
+			}
+		}
+		if len(resume.Experience) > 0 {
+			if err := tx.Create(&resume.Experience).Error; err != nil {
+				return err
+			}
+		}
+		if len(resume.Skills) > 0 {
+			if err := tx.Create(&resume.Skills).Error; err != nil {
+				return err
+			}
+		}
+		if len(resume.Languages) > 0 {
+			if err := tx.Create(&resume.Languages).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Delete удаляет резюме по UUID (разделы удаляются каскадно)
+func (r *resumeRepository) Delete(id uuid.UUID) error {
+	result := r.db.Delete(&models.Resume{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrResumeNotFound
+	}
+	return nil
+}
+
+// withSections добавляет к запросу загрузку разделов резюме в порядке отображения
+func withSections(db *gorm.DB) *gorm.DB {
+	ordered := func(db *gorm.DB) *gorm.DB {
+		return db.Order("position")
+	}
+	return db.
+		Preload("Education", ordered).
+		Preload("Experience", ordered).
+		Preload("Skills", ordered).
+		Preload("Languages", ordered)
+}
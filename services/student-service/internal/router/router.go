@@ -0,0 +1,86 @@
+package router
+
+import (
+	"net/http"
+	"observability/health"
+	"student-service/internal/dto"
+	"student-service/internal/handler"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SetupRouter настраивает и возвращает роутер Gin
+func SetupRouter(studentHandler *handler.StudentHandler, healthHandler *health.Handler) *gin.Engine {
+	// Создание роутера с стандартными middleware (Logger и Recovery)
+	r := gin.Default()
+
+	// Группа маршрутов студента (пользователь аутентифицирован API Gateway)
+	me := r.Group("/api/students/me")
+	me.Use(userMiddleware(), requireRoles("student"))
+	{
+		me.GET("", studentHandler.GetProfile)
+		me.PUT("", studentHandler.SaveProfile)
+
+		// Резюме текущего студента
+		me.GET("/resumes", studentHandler.ListResumes)
+		me.POST("/resumes", studentHandler.CreateResume)
+		me.GET("/resumes/:id", studentHandler.GetResume)
+		me.PUT("/resumes/:id", studentHandler.UpdateResume)
+		me.DELETE("/resumes/:id", studentHandler.DeleteResume)
+	}
+
+	// Внутренние маршруты для других сервисов (не проксируются API Gateway)
+	internal := r.Group("/internal")
+	{
+		internal.GET("/resumes/:id", studentHandler.GetResumeInternal)
+	}
+
+	// Проверки состояния: живость процесса и готовность (база данных доступна, сервер не останавливается).
+	// /health оставлен для совместимости и равносилен /health/ready
+	r.GET("/health", healthHandler.Ready)
+	r.GET("/health/live", healthHandler.Live)
+	r.GET("/health/ready", healthHandler.Ready)
+
+	return r
+}
+
+// userMiddleware извлекает ID, роль и email пользователя из заголовков X-User-ID, X-User-Role и X-User-Email,
+// который API Gateway устанавливает после проверки JWT токена
+func userMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := uuid.Parse(c.GetHeader("X-User-ID"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
+				Error:   "Не авторизован",
+				Message: "Отсутствует или некорректен заголовок X-User-ID",
+			})
+			return
+		}
+
+		// Сохранение данных пользователя в контексте запроса
+		c.Set("user_id", userID)
+		c.Set("user_role", c.GetHeader("X-User-Role"))
+		c.Set("user_email", c.GetHeader("X-User-Email"))
+
+		c.Next()
+	}
+}
+
+// requireRoles пропускает только пользователей с одной из указанных ролей
+func requireRoles(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := c.GetString("user_role")
+		for _, allowed := range roles {
+			if role == allowed {
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "Доступ запрещён",
+			Message: "Недостаточно прав для выполнения операции",
+		})
+	}
+}
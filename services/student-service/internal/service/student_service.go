@@ -0,0 +1,205 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"student-service/internal/dto"
+	"student-service/internal/models"
+	"student-service/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// Ошибки сервиса студентов
+var (
+	ErrNotResumeOwner = errors.New("резюме принадлежит другому студенту")
+	ErrInvalidPeriod  = errors.New("дата окончания раньше даты начала")
+)
+
+// StudentService определяет интерфейс сервиса студентов
+type StudentService interface {
+	GetProfile(userID uuid.UUID) (*models.StudentProfile, error)
+	SaveProfile(userID uuid.UUID, req *dto.ProfileRequest) (*models.StudentProfile, error)
+	ListResumes(userID uuid.UUID) ([]models.Resume, error)
+	GetResume(userID, resumeID uuid.UUID) (*models.Resume, error)
+	GetResumeByID(resumeID uuid.UUID) (*models.Resume, error)
+	CreateResume(userID uuid.UUID, req *dto.ResumeRequest) (*models.Resume, error)
+	UpdateResume(userID, resumeID uuid.UUID, req *dto.ResumeRequest) (*models.Resume, error)
+	DeleteResume(userID, resumeID uuid.UUID) error
+}
+
+// studentService реализует StudentService
+type studentService struct {
+	profileRepo repository.ProfileRepository
+	resumeRepo  repository.ResumeRepository
+}
+
+// NewStudentService создаёт новый экземпляр сервиса студентов
+func NewStudentService(profileRepo repository.ProfileRepository, resumeRepo repository.ResumeRepository) StudentService {
+	return &studentService{
+		profileRepo: profileRepo,
+		resumeRepo:  resumeRepo,
+	}
+}
+
+// GetProfile возвращает профиль студента
+func (s *studentService) GetProfile(userID uuid.UUID) (*models.StudentProfile, error) {
+	return s.profileRepo.FindByUserID(userID)
+}
+
+// SaveProfile создаёт или обновляет профиль студента
+func (s *studentService) SaveProfile(userID uuid.UUID, req *dto.ProfileRequest) (*models.StudentProfile, error) {
+	birthDate, err := models.ParseDate(req.BirthDate)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.profileRepo.FindByUserID(userID)
+	if errors.Is(err, repository.ErrProfileNotFound) {
+		profile, err = &models.StudentProfile{UserID: userID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	profile.IIN = req.IIN
+	profile.LastName = strings.TrimSpace(req.LastName)
+	profile.FirstName = strings.TrimSpace(req.FirstName)
+	profile.MiddleName = strings.TrimSpace(req.MiddleName)
+	profile.BirthDate = birthDate
+	profile.Phone = req.Phone
+	profile.University = strings.TrimSpace(req.University)
+	profile.Faculty = strings.TrimSpace(req.Faculty)
+	profile.GraduationYear = req.GraduationYear
+
+	if err := s.profileRepo.Save(profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// ListResumes возвращает все резюме студента
+func (s *studentService) ListResumes(userID uuid.UUID) ([]models.Resume, error) {
+	return s.resumeRepo.ListByStudent(userID)
+}
+
+// GetResume возвращает резюме студента по ID
+func (s *studentService) GetResume(userID, resumeID uuid.UUID) (*models.Resume, error) {
+	return s.findOwned(userID, resumeID)
+}
+
+// GetResumeByID возвращает резюме без проверки владельца (для внутренних запросов сервисов)
+func (s *studentService) GetResumeByID(resumeID uuid.UUID) (*models.Resume, error) {
+	return s.resumeRepo.FindByID(resumeID)
+}
+
+// CreateResume создаёт новое резюме студента
+func (s *studentService) CreateResume(userID uuid.UUID, req *dto.ResumeRequest) (*models.Resume, error) {
+	resume := &models.Resume{
+		ID:        uuid.New(),
+		StudentID: userID,
+	}
+	if err := applyResumeRequest(resume, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.resumeRepo.Create(resume); err != nil {
+		return nil, err
+	}
+	return resume, nil
+}
+
+// UpdateResume полностью заменяет содержимое резюме студента
+func (s *studentService) UpdateResume(userID, resumeID uuid.UUID, req *dto.ResumeRequest) (*models.Resume, error) {
+	resume, err := s.findOwned(userID, resumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyResumeRequest(resume, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.resumeRepo.Replace(resume); err != nil {
+		return nil, err
+	}
+	return s.resumeRepo.FindByID(resume.ID)
+}
+
+// DeleteResume удаляет резюме студента
+func (s *studentService) DeleteResume(userID, resumeID uuid.UUID) error {
+	if _, err := s.findOwned(userID, resumeID); err != nil {
+		return err
+	}
+	return s.resumeRepo.Delete(resumeID)
+}
+
+// findOwned загружает резюме и проверяет, что оно принадлежит студенту
+func (s *studentService) findOwned(userID, resumeID uuid.UUID) (*models.Resume, error) {
+	resume, err := s.resumeRepo.FindByID(resumeID)
+	if err != nil {
+		return nil, err
+	}
+	if resume.StudentID != userID {
+		return nil, ErrNotResumeOwner
+	}
+	return resume, nil
+}
+
+// applyResumeRequest переносит поля запроса в модель резюме, заменяя все разделы
+func applyResumeRequest(resume *models.Resume, req *dto.ResumeRequest) error {
+	resume.Title = strings.TrimSpace(req.Title)
+	resume.Summary = req.Summary
+
+	resume.Education = make([]models.ResumeEducation, 0, len(req.Education))
+	for i, e := range req.Education {
+		resume.Education = append(resume.Education, models.ResumeEducation{
+			ResumeID:     resume.ID,
+			Position:     i,
+			Institution:  strings.TrimSpace(e.Institution),
+			Degree:       strings.TrimSpace(e.Degree),
+			FieldOfStudy: strings.TrimSpace(e.FieldOfStudy),
+			StartYear:    e.StartYear,
+			EndYear:      e.EndYear,
+		})
+	}
+
+	resume.Experience = make([]models.ResumeExperience, 0, len(req.Experience))
+	for i, e := range req.Experience {
+		// Даты в формате ГГГГ-ММ сравниваются лексикографически
+		if e.EndDate != "" && e.EndDate < e.StartDate {
+			return ErrInvalidPeriod
+		}
+		resume.Experience = append(resume.Experience, models.ResumeExperience{
+			ResumeID:    resume.ID,
+			Position:    i,
+			Company:     strings.TrimSpace(e.Company),
+			JobTitle:    strings.TrimSpace(e.JobTitle),
+			StartDate:   e.StartDate,
+			EndDate:     e.EndDate,
+			Description: e.Description,
+		})
+	}
+
+	resume.Skills = make([]models.ResumeSkill, 0, len(req.Skills))
+	for i, skill := range req.Skills {
+		resume.Skills = append(resume.Skills, models.ResumeSkill{
+			ResumeID: resume.ID,
+			Position: i,
+			Name:     strings.TrimSpace(skill.Name),
+			Level:    skill.Level,
+		})
+	}
+
+	resume.Languages = make([]models.ResumeLanguage, 0, len(req.Languages))
+	for i, language := range req.Languages {
+		resume.Languages = append(resume.Languages, models.ResumeLanguage{
+			ResumeID: resume.ID,
+			Position: i,
+			Name:     strings.TrimSpace(language.Name),
+			Level:    language.Level,
+		})
+	}
+
+	return nil
+}
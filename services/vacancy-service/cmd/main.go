@@ -1 +1,79 @@
-package cmd
+package main
+
+import (
+	"log"
+	"observability/health"
+	"observability/server"
+	"vacancy-service/internal/client"
+	"vacancy-service/internal/config"
+	"vacancy-service/internal/handler"
+	"vacancy-service/internal/models"
+	"vacancy-service/internal/repository"
+	"vacancy-service/internal/router"
+	"vacancy-service/internal/service"
+)
+
+// @title Vacancy Service API
+// @version 1.0
+// @description Сервис вакансий для системы трудоустройства студентов
+// @host localhost:8084
+// @BasePath /api
+
+func main() {
+	// Загрузка конфигурации из переменных окружения
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Ошибка загрузки конфигурации: %v", err)
+	}
+
+	// Подключение к базе данных PostgreSQL
+	db, err := config.ConnectDatabase(cfg)
+	if err != nil {
+		log.Fatalf("Ошибка подключения к базе данных: %v", err)
+	}
+
+	// Разбор воронки найма
+	pipeline, err := models.ParsePipeline(cfg.ApplicationPipeline)
+	if err != nil {
+		log.Fatalf("Ошибка конфигурации воронки найма: %v", err)
+	}
+
+	// Клиенты других сервисов
+	employerClient := client.NewEmployerClient(cfg.EmployerServiceURL)
+	studentClient := client.NewStudentClient(cfg.StudentServiceURL)
+
+	// Инициализация слоёв приложения
+	vacancyRepo := repository.NewVacancyRepository(db)
+	applicationRepo := repository.NewApplicationRepository(db)
+	vacancyService := service.NewVacancyService(vacancyRepo, employerClient)
+	applicationService := service.NewApplicationService(applicationRepo, vacancyRepo, studentClient, employerClient, pipeline)
+	vacancyHandler := handler.NewVacancyHandler(vacancyService)
+	applicationHandler := handler.NewApplicationHandler(applicationService)
+
+	// Пул соединений с БД проверяется в /health/ready и закрывается после завершения обрабатываемых запросов
+	sqlDB, err := db.DB()
+	if err != nil {
+		log.Fatalf("Ошибка получения пула соединений с базой данных: %v", err)
+	}
+	readiness := server.NewReadiness()
+	healthHandler := health.NewHandler("vacancy-service", sqlDB, readiness, cfg.HealthCheckTimeout)
+
+	// Создание и настройка роутера
+	r := router.SetupRouter(vacancyHandler, applicationHandler, healthHandler)
+
+	// Запуск HTTP сервера (до SIGINT/SIGTERM)
+	log.Printf("Vacancy Service запущен на порту %s", cfg.ServerPort)
+	err = server.Run(r, server.Config{
+		Addr:              ":" + cfg.ServerPort,
+		ReadTimeout:       cfg.ServerReadTimeout,
+		ReadHeaderTimeout: cfg.ServerReadHeaderTimeout,
+		WriteTimeout:      cfg.ServerWriteTimeout,
+		IdleTimeout:       cfg.ServerIdleTimeout,
+		ShutdownDelay:     cfg.ShutdownDelay,
+		ShutdownTimeout:   cfg.ShutdownTimeout,
+	}, readiness, sqlDB.Close)
+	if err != nil {
+		log.Fatalf("Ошибка работы сервера: %v", err)
+	}
+	log.Printf("Vacancy Service остановлен")
+}
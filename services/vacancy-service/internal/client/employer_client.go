@@ -0,0 +1,92 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrEmployerServiceUnavailable возвращается, если employer-service недоступен
+var ErrEmployerServiceUnavailable = errors.New("сервис работодателей недоступен")
+
+// EmployerClient определяет интерфейс обращения к employer-service
+type EmployerClient interface {
+	IsVerified(userID uuid.UUID) (bool, error)
+	VerifiedEmployers() ([]uuid.UUID, error)
+}
+
+// employerClient реализует EmployerClient поверх HTTP
+type employerClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// verificationResponse - ответ внутреннего эндпоинта проверки компании
+type verificationResponse struct {
+	Verified bool `json:"verified"`
+}
+
+// verifiedRecruitersResponse - ответ внутреннего эндпоинта со списком рекрутеров подтверждённых компаний
+type verifiedRecruitersResponse struct {
+	UserIDs []uuid.UUID `json:"userIds"`
+}
+
+// NewEmployerClient создаёт новый клиент employer-service
+func NewEmployerClient(baseURL string) EmployerClient {
+	return &employerClient{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// IsVerified проверяет, что пользователь состоит в компании с подтверждённым БИН
+func (c *employerClient) IsVerified(userID uuid.UUID) (bool, error) {
+	url := fmt.Sprintf("%s/internal/recruiters/%s/verification", c.baseURL, userID)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", ErrEmployerServiceUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		var body verificationResponse
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return false, fmt.Errorf("%w: %v", ErrEmployerServiceUnavailable, err)
+		}
+		return body.Verified, nil
+	case http.StatusNotFound:
+		// Пользователь не состоит ни в одной компании
+		return false, nil
+	default:
+		return false, fmt.Errorf("%w: статус %d", ErrEmployerServiceUnavailable, resp.StatusCode)
+	}
+}
+
+// VerifiedEmployers возвращает пользователей, которые сейчас состоят в компаниях с подтверждённым БИН
+func (c *employerClient) VerifiedEmployers() ([]uuid.UUID, error) {
+	resp, err := c.httpClient.Get(c.baseURL + "/internal/recruiters/verified")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrEmployerServiceUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: статус %d", ErrEmployerServiceUnavailable, resp.StatusCode)
+	}
+
+	var body verifiedRecruitersResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrEmployerServiceUnavailable, err)
+	}
+	if body.UserIDs == nil {
+		body.UserIDs = []uuid.UUID{}
+	}
+	return body.UserIDs, nil
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Ошибки клиента student-service
+var (
+	ErrStudentServiceUnavailable = errors.New("сервис студентов недоступен")
+	ErrResumeNotFound            = errors.New("резюме не найдено")
+)
+
+// Resume содержит резюме студента в исходном JSON представлении
+type Resume struct {
+	StudentID uuid.UUID
+	Raw       json.RawMessage
+}
+
+// StudentClient определяет интерфейс обращения к student-service
+type StudentClient interface {
+	GetResume(resumeID uuid.UUID) (*Resume, error)
+}
+
+// studentClient реализует StudentClient поверх HTTP
+type studentClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewStudentClient создаёт новый клиент student-service
+func NewStudentClient(baseURL string) StudentClient {
+	return &studentClient{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// GetResume загружает резюме по ID через внутренний эндпоинт student-service
+func (c *studentClient) GetResume(resumeID uuid.UUID) (*Resume, error) {
+	url := fmt.Sprintf("%s/internal/resumes/%s", c.baseURL, resumeID)
+
+	resp, err := c.httpClient.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrStudentServiceUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrResumeNotFound
+	default:
+		return nil, fmt.Errorf("%w: статус %d", ErrStudentServiceUnavailable, resp.StatusCode)
+	}
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrStudentServiceUnavailable, err)
+	}
+
+	// Из резюме нужен только владелец, остальное сохраняется как есть
+	var header struct {
+		StudentID uuid.UUID `json:"studentId"`
+	}
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrStudentServiceUnavailable, err)
+	}
+
+	return &Resume{
+		StudentID: header.StudentID,
+		Raw:       raw,
+	}, nil
+}
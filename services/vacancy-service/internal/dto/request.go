@@ -0,0 +1,51 @@
+package dto
+
+import "vacancy-service/internal/models"
+
+// VacancyRequest представляет запрос на создание или обновление вакансии
+type VacancyRequest struct {
+	Title          string                `json:"title" binding:"required,max=255" example:"Junior Go разработчик"`
+	Description    string                `json:"description" binding:"required" example:"Разработка микросервисов"`
+	Requirements   string                `json:"requirements" example:"Знание Go и PostgreSQL"`
+	Location       string                `json:"location" binding:"max=255" example:"Алматы"`
+	EmploymentType models.EmploymentType `json:"employmentType" binding:"omitempty,oneof=full_time part_time internship contract" example:"internship"`
+	SalaryFrom     *int                  `json:"salaryFrom" binding:"omitempty,min=0" example:"300000"`
+	SalaryTo       *int                  `json:"salaryTo" binding:"omitempty,min=0" example:"450000"`
+	Currency       string                `json:"currency" binding:"omitempty,len=3,alpha" example:"KZT"`
+}
+
+// ListVacanciesQuery представляет параметры поиска опубликованных вакансий
+type ListVacanciesQuery struct {
+	Search         string                `form:"q" example:"go"`
+	EmployerID     string                `form:"employerId" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	EmploymentType models.EmploymentType `form:"employmentType" binding:"omitempty,oneof=full_time part_time internship contract" example:"internship"`
+	Location       string                `form:"location" example:"Алматы"`
+	Page           int                   `form:"page" binding:"omitempty,min=1" example:"1"`
+	PageSize       int                   `form:"pageSize" binding:"omitempty,min=1,max=100" example:"20"`
+}
+
+// ApplyRequest представляет отклик студента на вакансию
+type ApplyRequest struct {
+	VacancyID   string `json:"vacancyId" binding:"required,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ResumeID    string `json:"resumeId" binding:"required,uuid" example:"550e8400-e29b-41d4-a716-446655440001"`
+	CoverLetter string `json:"coverLetter" binding:"max=5000" example:"Здравствуйте! Меня заинтересовала ваша вакансия..."`
+}
+
+// ChangeStatusRequest представляет запрос работодателя на смену статуса отклика
+type ChangeStatusRequest struct {
+	Status  models.ApplicationStatus `json:"status" binding:"required,oneof=screening interview offer hired rejected" example:"interview"`
+	Comment string                   `json:"comment" binding:"max=2000" example:"Приглашение на собеседование 12.03 в 15:00"`
+}
+
+// WithdrawRequest представляет запрос студента на отзыв отклика
+type WithdrawRequest struct {
+	Comment string `json:"comment" binding:"max=2000" example:"Принял другое предложение"`
+}
+
+// ListApplicationsQuery представляет параметры выборки откликов работодателя
+type ListApplicationsQuery struct {
+	VacancyID string                   `form:"vacancyId" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status    models.ApplicationStatus `form:"status" binding:"omitempty,oneof=submitted screening interview offer hired rejected withdrawn" example:"submitted"`
+	Page      int                      `form:"page" binding:"omitempty,min=1" example:"1"`
+	PageSize  int                      `form:"pageSize" binding:"omitempty,min=1,max=100" example:"20"`
+}
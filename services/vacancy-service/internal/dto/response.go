@@ -0,0 +1,31 @@
+package dto
+
+import "vacancy-service/internal/models"
+
+// VacancyListResponse представляет страницу списка вакансий
+type VacancyListResponse struct {
+	Items    []models.Vacancy `json:"items"`
+	Total    int64            `json:"total" example:"42"`
+	Page     int              `json:"page" example:"1"`
+	PageSize int              `json:"pageSize" example:"20"`
+}
+
+// ApplicationListResponse представляет страницу списка откликов
+type ApplicationListResponse struct {
+	Items    []models.Application `json:"items"`
+	Total    int64                `json:"total" example:"42"`
+	Page     int                  `json:"page" example:"1"`
+	PageSize int                  `json:"pageSize" example:"20"`
+}
+
+// ErrorResponse представляет ответ с ошибкой
+type ErrorResponse struct {
+	Error   string            `json:"error" example:"Ошибка валидации"`
+	Message string            `json:"message" example:"Вакансия не найдена"`
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// SuccessResponse представляет успешный ответ без данных
+type SuccessResponse struct {
+	Message string `json:"message" example:"Операция выполнена успешно"`
+}
@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"vacancy-service/internal/dto"
+	"vacancy-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ApplicationHandler обрабатывает HTTP запросы откликов на вакансии
+type ApplicationHandler struct {
+	applicationService service.ApplicationService
+}
+
+// NewApplicationHandler создаёт новый экземпляр обработчика откликов
+func NewApplicationHandler(applicationService service.ApplicationService) *ApplicationHandler {
+	return &ApplicationHandler{
+		applicationService: applicationService,
+	}
+}
+
+// Apply создаёт отклик студента на вакансию
+// @Summary Отклик на вакансию
+// @Description Создаёт отклик на опубликованную вакансию с копией выбранного резюме и сопроводительным письмом
+// @Tags applications
+// @Accept json
+// @Produce json
+// @Param request body dto.ApplyRequest true "Данные отклика"
+// @Success 201 {object} models.Application
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /applications [post]
+func (h *ApplicationHandler) Apply(c *gin.Context) {
+	studentID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.ApplyRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	application, err := h.applicationService.Apply(studentID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, application)
+}
+
+// ListOwn возвращает отклики текущего студента
+// @Summary Мои отклики
+// @Description Возвращает отклики текущего студента
+// @Tags applications
+// @Produce json
+// @Param page query int false "Номер страницы"
+// @Param pageSize query int false "Размер страницы"
+// @Success 200 {object} dto.ApplicationListResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /applications/my [get]
+func (h *ApplicationHandler) ListOwn(c *gin.Context) {
+	studentID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	page, _ := strconv.Atoi(c.Query("page"))
+	pageSize, _ := strconv.Atoi(c.Query("pageSize"))
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	response, err := h.applicationService.ListOwn(studentID, page, pageSize)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListForEmployer возвращает отклики на вакансии текущего работодателя
+// @Summary Отклики на мои вакансии
+// @Description Возвращает отклики на вакансии текущего работодателя с фильтром по вакансии и статусу
+// @Tags applications
+// @Produce json
+// @Param vacancyId query string false "ID вакансии"
+// @Param status query string false "Статус отклика"
+// @Param page query int false "Номер страницы"
+// @Param pageSize query int false "Размер страницы"
+// @Success 200 {object} dto.ApplicationListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /applications [get]
+func (h *ApplicationHandler) ListForEmployer(c *gin.Context) {
+	employerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var query dto.ListApplicationsQuery
+
+	// Парсинг и валидация параметров запроса
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response, err := h.applicationService.ListForEmployer(employerID, &query)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Get возвращает отклик с историей статусов
+// @Summary Получение отклика
+// @Description Возвращает отклик с резюме и полной историей статусов студенту-автору или работодателю
+// @Tags applications
+// @Produce json
+// @Param id path string true "ID отклика"
+// @Success 200 {object} models.Application
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /applications/{id} [get]
+func (h *ApplicationHandler) Get(c *gin.Context) {
+	callerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	applicationID, ok := uuidParam(c, "Некорректный ID отклика")
+	if !ok {
+		return
+	}
+
+	application, err := h.applicationService.Get(callerID, applicationID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application)
+}
+
+// ChangeStatus переводит отклик на следующий этап воронки
+// @Summary Смена статуса отклика
+// @Description Переводит отклик на следующий этап воронки найма (для работодателя-владельца вакансии)
+// @Tags applications
+// @Accept json
+// @Produce json
+// @Param id path string true "ID отклика"
+// @Param request body dto.ChangeStatusRequest true "Новый статус"
+// @Success 200 {object} models.Application
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /applications/{id}/status [post]
+func (h *ApplicationHandler) ChangeStatus(c *gin.Context) {
+	employerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	applicationID, ok := uuidParam(c, "Некорректный ID отклика")
+	if !ok {
+		return
+	}
+
+	var req dto.ChangeStatusRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	application, err := h.applicationService.ChangeStatus(employerID, applicationID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application)
+}
+
+// Withdraw отзывает отклик студента
+// @Summary Отзыв отклика
+// @Description Отзывает незавершённый отклик текущего студента
+// @Tags applications
+// @Accept json
+// @Produce json
+// @Param id path string true "ID отклика"
+// @Param request body dto.WithdrawRequest false "Комментарий"
+// @Success 200 {object} models.Application
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /applications/{id}/withdraw [post]
+func (h *ApplicationHandler) Withdraw(c *gin.Context) {
+	studentID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	applicationID, ok := uuidParam(c, "Некорректный ID отклика")
+	if !ok {
+		return
+	}
+
+	// Тело запроса необязательно
+	var req dto.WithdrawRequest
+	if c.Request.ContentLength > 0 && !bindJSON(c, &req) {
+		return
+	}
+
+	application, err := h.applicationService.Withdraw(studentID, applicationID, req.Comment)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, application)
+}
@@ -0,0 +1,408 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"vacancy-service/internal/client"
+	"vacancy-service/internal/dto"
+	"vacancy-service/internal/models"
+	"vacancy-service/internal/repository"
+	"vacancy-service/internal/service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// VacancyHandler обрабатывает HTTP запросы вакансий
+type VacancyHandler struct {
+	vacancyService service.VacancyService
+}
+
+// NewVacancyHandler создаёт новый экземпляр обработчика вакансий
+func NewVacancyHandler(vacancyService service.VacancyService) *VacancyHandler {
+	return &VacancyHandler{
+		vacancyService: vacancyService,
+	}
+}
+
+// List возвращает опубликованные вакансии
+// @Summary Поиск вакансий
+// @Description Возвращает опубликованные вакансии с фильтрами и пагинацией
+// @Tags vacancies
+// @Produce json
+// @Param q query string false "Поиск по названию и описанию"
+// @Param employerId query string false "ID работодателя"
+// @Param employmentType query string false "Тип занятости"
+// @Param location query string false "Город"
+// @Param page query int false "Номер страницы"
+// @Param pageSize query int false "Размер страницы"
+// @Success 200 {object} dto.VacancyListResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /vacancies [get]
+func (h *VacancyHandler) List(c *gin.Context) {
+	var query dto.ListVacanciesQuery
+
+	// Парсинг и валидация параметров запроса
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	response, err := h.vacancyService.ListPublished(&query)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListOwn возвращает вакансии текущего работодателя
+// @Summary Мои вакансии
+// @Description Возвращает все вакансии текущего работодателя, включая черновики и закрытые
+// @Tags vacancies
+// @Produce json
+// @Param page query int false "Номер страницы"
+// @Param pageSize query int false "Размер страницы"
+// @Success 200 {object} dto.VacancyListResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /vacancies/my [get]
+func (h *VacancyHandler) ListOwn(c *gin.Context) {
+	employerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	page, _ := strconv.Atoi(c.Query("page"))
+	pageSize, _ := strconv.Atoi(c.Query("pageSize"))
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	response, err := h.vacancyService.ListOwn(employerID, page, pageSize)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// Get возвращает вакансию по ID
+// @Summary Получение вакансии
+// @Description Возвращает вакансию; черновик и вакансия неподтверждённой компании доступны только владельцу
+// @Tags vacancies
+// @Produce json
+// @Param id path string true "ID вакансии"
+// @Success 200 {object} models.Vacancy
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /vacancies/{id} [get]
+func (h *VacancyHandler) Get(c *gin.Context) {
+	callerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	vacancyID, ok := vacancyIDParam(c)
+	if !ok {
+		return
+	}
+
+	vacancy, err := h.vacancyService.Get(callerID, vacancyID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, vacancy)
+}
+
+// Create создаёт новую вакансию
+// @Summary Создание вакансии
+// @Description Создаёт вакансию текущего работодателя в состоянии черновика
+// @Tags vacancies
+// @Accept json
+// @Produce json
+// @Param request body dto.VacancyRequest true "Данные вакансии"
+// @Success 201 {object} models.Vacancy
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 401 {object} dto.ErrorResponse
+// @Router /vacancies [post]
+func (h *VacancyHandler) Create(c *gin.Context) {
+	employerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+
+	var req dto.VacancyRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	vacancy, err := h.vacancyService.Create(employerID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, vacancy)
+}
+
+// Update обновляет вакансию
+// @Summary Обновление вакансии
+// @Description Обновляет вакансию, принадлежащую текущему работодателю
+// @Tags vacancies
+// @Accept json
+// @Produce json
+// @Param id path string true "ID вакансии"
+// @Param request body dto.VacancyRequest true "Данные вакансии"
+// @Success 200 {object} models.Vacancy
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /vacancies/{id} [put]
+func (h *VacancyHandler) Update(c *gin.Context) {
+	employerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	vacancyID, ok := vacancyIDParam(c)
+	if !ok {
+		return
+	}
+
+	var req dto.VacancyRequest
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	vacancy, err := h.vacancyService.Update(employerID, vacancyID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, vacancy)
+}
+
+// Delete удаляет вакансию
+// @Summary Удаление вакансии
+// @Description Удаляет вакансию, принадлежащую текущему работодателю
+// @Tags vacancies
+// @Produce json
+// @Param id path string true "ID вакансии"
+// @Success 200 {object} dto.SuccessResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /vacancies/{id} [delete]
+func (h *VacancyHandler) Delete(c *gin.Context) {
+	employerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	vacancyID, ok := vacancyIDParam(c)
+	if !ok {
+		return
+	}
+
+	if err := h.vacancyService.Delete(employerID, vacancyID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{Message: "Вакансия удалена"})
+}
+
+// Publish публикует вакансию
+// @Summary Публикация вакансии
+// @Description Публикует черновик или повторно открывает закрытую вакансию; требуется подтверждённый БИН компании
+// @Tags vacancies
+// @Produce json
+// @Param id path string true "ID вакансии"
+// @Success 200 {object} models.Vacancy
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /vacancies/{id}/publish [post]
+func (h *VacancyHandler) Publish(c *gin.Context) {
+	h.changeStatus(c, h.vacancyService.Publish)
+}
+
+// Close закрывает вакансию
+// @Summary Закрытие вакансии
+// @Description Закрывает опубликованную вакансию
+// @Tags vacancies
+// @Produce json
+// @Param id path string true "ID вакансии"
+// @Success 200 {object} models.Vacancy
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /vacancies/{id}/close [post]
+func (h *VacancyHandler) Close(c *gin.Context) {
+	h.changeStatus(c, h.vacancyService.Close)
+}
+
+// changeStatus выполняет переход состояния вакансии указанной операцией сервиса
+func (h *VacancyHandler) changeStatus(c *gin.Context, transition func(employerID, vacancyID uuid.UUID) (*models.Vacancy, error)) {
+	employerID, ok := currentUserID(c)
+	if !ok {
+		return
+	}
+	vacancyID, ok := vacancyIDParam(c)
+	if !ok {
+		return
+	}
+
+	vacancy, err := transition(employerID, vacancyID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, vacancy)
+}
+
+// bindJSON парсит и валидирует тело запроса
+func bindJSON(c *gin.Context, req interface{}) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: err.Error(),
+		})
+		return false
+	}
+	return true
+}
+
+// currentUserID извлекает ID пользователя из контекста (установлен middleware).
+// При ошибке ответ уже отправлен клиенту и возвращается false.
+func currentUserID(c *gin.Context) (uuid.UUID, bool) {
+	userID, exists := c.Get("user_id")
+	id, ok := userID.(uuid.UUID)
+	if !exists || !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "Не авторизован",
+			Message: "Требуется аутентификация",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// vacancyIDParam извлекает ID вакансии из пути запроса
+func vacancyIDParam(c *gin.Context) (uuid.UUID, bool) {
+	return uuidParam(c, "Некорректный ID вакансии")
+}
+
+// uuidParam извлекает UUID из параметра пути id
+func uuidParam(c *gin.Context, message string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: message,
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// handleServiceError обрабатывает ошибки сервиса и возвращает соответствующий HTTP ответ
+func handleServiceError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrVacancyNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Не найдено",
+			Message: "Вакансия не найдена",
+		})
+	case errors.Is(err, service.ErrNotVacancyOwner):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "Доступ запрещён",
+			Message: "Вакансия принадлежит другому работодателю",
+		})
+	case errors.Is(err, service.ErrInvalidStatusTransition):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Недопустимый переход состояния вакансии",
+		})
+	case errors.Is(err, service.ErrClosedVacancyModification):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Закрытую вакансию нельзя редактировать",
+		})
+	case errors.Is(err, service.ErrEmployerNotVerified):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "Доступ запрещён",
+			Message: "Публикация вакансий доступна после подтверждения БИН компании",
+		})
+	case errors.Is(err, client.ErrEmployerServiceUnavailable):
+		c.JSON(http.StatusServiceUnavailable, dto.ErrorResponse{
+			Error:   "Сервис недоступен",
+			Message: "Не удалось проверить статус компании, повторите попытку позже",
+		})
+	case errors.Is(err, service.ErrInvalidSalaryRange):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "Ошибка валидации",
+			Message: "Минимальная зарплата больше максимальной",
+		})
+	case errors.Is(err, repository.ErrApplicationNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Не найдено",
+			Message: "Отклик не найден",
+		})
+	case errors.Is(err, client.ErrResumeNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "Не найдено",
+			Message: "Резюме не найдено",
+		})
+	case errors.Is(err, repository.ErrApplicationAlreadyExists):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Вы уже откликались на эту вакансию",
+		})
+	case errors.Is(err, repository.ErrApplicationStatusChanged):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Статус отклика был изменён, обновите данные",
+		})
+	case errors.Is(err, service.ErrVacancyNotOpen):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Вакансия не принимает отклики",
+		})
+	case errors.Is(err, service.ErrInvalidApplicationTransition):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "Конфликт",
+			Message: "Недопустимый переход статуса отклика",
+		})
+	case errors.Is(err, service.ErrNotResumeOwner):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "Доступ запрещён",
+			Message: "Резюме принадлежит другому студенту",
+		})
+	case errors.Is(err, service.ErrNotApplicationParticipant):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "Доступ запрещён",
+			Message: "Отклик недоступен текущему пользователю",
+		})
+	case errors.Is(err, client.ErrStudentServiceUnavailable):
+		c.JSON(http.StatusServiceUnavailable, dto.ErrorResponse{
+			Error:   "Сервис недоступен",
+			Message: "Не удалось получить резюме, повторите попытку позже",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "Внутренняя ошибка сервера",
+			Message: "Произошла непредвиденная ошибка",
+		})
+	}
+}
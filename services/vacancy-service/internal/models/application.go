@@ -0,0 +1,82 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ApplicationStatus определяет этап отклика в воронке найма
+type ApplicationStatus string
+
+const (
+	ApplicationSubmitted ApplicationStatus = "submitted" // Отклик отправлен
+	ApplicationScreening ApplicationStatus = "screening" // Рассмотрение резюме
+	ApplicationInterview ApplicationStatus = "interview" // Собеседование
+	ApplicationOffer     ApplicationStatus = "offer"     // Сделано предложение
+	ApplicationHired     ApplicationStatus = "hired"     // Кандидат принят
+	ApplicationRejected  ApplicationStatus = "rejected"  // Отказ работодателя
+	ApplicationWithdrawn ApplicationStatus = "withdrawn" // Отозван студентом
+)
+
+// IsValid проверяет, является ли статус допустимым
+func (s ApplicationStatus) IsValid() bool {
+	switch s {
+	case ApplicationSubmitted, ApplicationScreening, ApplicationInterview,
+		ApplicationOffer, ApplicationHired, ApplicationRejected, ApplicationWithdrawn:
+		return true
+	}
+	return false
+}
+
+// IsFinal проверяет, является ли статус завершающим
+func (s ApplicationStatus) IsFinal() bool {
+	return s == ApplicationHired || s == ApplicationRejected || s == ApplicationWithdrawn
+}
+
+// Application представляет отклик студента на вакансию
+type Application struct {
+	ID             uuid.UUID                 `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	VacancyID      uuid.UUID                 `gorm:"type:uuid;not null;uniqueIndex:idx_application_vacancy_student" json:"vacancyId"`
+	StudentID      uuid.UUID                 `gorm:"type:uuid;not null;uniqueIndex:idx_application_vacancy_student;index" json:"studentId"`
+	EmployerID     uuid.UUID                 `gorm:"type:uuid;index;not null" json:"employerId"`
+	ResumeID       uuid.UUID                 `gorm:"type:uuid;not null" json:"resumeId"`
+	ResumeSnapshot json.RawMessage           `gorm:"type:jsonb;not null" json:"resume"` // Резюме на момент отклика
+	CoverLetter    string                    `gorm:"type:text" json:"coverLetter"`
+	Status         ApplicationStatus         `gorm:"type:varchar(16);index;not null;default:'submitted'" json:"status"`
+	History        []ApplicationStatusChange `gorm:"foreignKey:ApplicationID;constraint:OnDelete:CASCADE" json:"history,omitempty"`
+	CreatedAt      time.Time                 `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt      time.Time                 `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName возвращает имя таблицы для модели Application
+func (Application) TableName() string {
+	return "applications"
+}
+
+// BeforeCreate выполняется перед созданием записи
+func (a *Application) BeforeCreate(tx *gorm.DB) error {
+	// Генерация UUID если не задан
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return nil
+}
+
+// ApplicationStatusChange представляет запись истории смены статуса отклика
+type ApplicationStatusChange struct {
+	ID            uint              `gorm:"primaryKey" json:"-"`
+	ApplicationID uuid.UUID         `gorm:"type:uuid;index;not null" json:"-"`
+	FromStatus    ApplicationStatus `gorm:"type:varchar(16)" json:"fromStatus,omitempty"` // Пусто для первой записи
+	ToStatus      ApplicationStatus `gorm:"type:varchar(16);not null" json:"toStatus"`
+	ChangedBy     uuid.UUID         `gorm:"type:uuid;not null" json:"changedBy"`
+	Comment       string            `gorm:"type:text" json:"comment,omitempty"`
+	CreatedAt     time.Time         `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// TableName возвращает имя таблицы для модели ApplicationStatusChange
+func (ApplicationStatusChange) TableName() string {
+	return "application_status_history"
+}
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultPipelineSpec описывает воронку найма по умолчанию в формате
+// "статус>следующий,следующий;статус>следующий"
+const DefaultPipelineSpec = "submitted>screening,interview,rejected;" +
+	"screening>interview,offer,rejected;" +
+	"interview>offer,rejected;" +
+	"offer>hired,rejected"
+
+// Pipeline определяет переходы статусов отклика, доступные работодателю.
+// Отзыв отклика студентом (withdrawn) возможен из любого незавершённого статуса
+// и в воронку не входит.
+type Pipeline struct {
+	transitions map[ApplicationStatus][]ApplicationStatus
+}
+
+// ParsePipeline разбирает описание воронки найма
+func ParsePipeline(spec string) (*Pipeline, error) {
+	pipeline := &Pipeline{transitions: make(map[ApplicationStatus][]ApplicationStatus)}
+
+	for _, rule := range strings.Split(spec, ";") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+
+		from, targets, found := strings.Cut(rule, ">")
+		if !found {
+			return nil, fmt.Errorf("некорректное правило воронки %q", rule)
+		}
+
+		fromStatus := ApplicationStatus(strings.TrimSpace(from))
+		if !fromStatus.IsValid() || fromStatus.IsFinal() {
+			return nil, fmt.Errorf("недопустимый исходный статус %q", fromStatus)
+		}
+
+		for _, target := range strings.Split(targets, ",") {
+			toStatus := ApplicationStatus(strings.TrimSpace(target))
+			if !toStatus.IsValid() || toStatus == ApplicationSubmitted || toStatus == ApplicationWithdrawn {
+				return nil, fmt.Errorf("недопустимый целевой статус %q", toStatus)
+			}
+			pipeline.transitions[fromStatus] = append(pipeline.transitions[fromStatus], toStatus)
+		}
+	}
+
+	if len(pipeline.transitions[ApplicationSubmitted]) == 0 {
+		return nil, fmt.Errorf("в воронке нет переходов из статуса %q", ApplicationSubmitted)
+	}
+	return pipeline, nil
+}
+
+// CanTransition проверяет, может ли работодатель перевести отклик из одного статуса в другой
+func (p *Pipeline) CanTransition(from, to ApplicationStatus) bool {
+	for _, next := range p.transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
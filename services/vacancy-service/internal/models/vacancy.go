@@ -0,0 +1,73 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// VacancyStatus определяет состояние вакансии
+type VacancyStatus string
+
+const (
+	StatusDraft     VacancyStatus = "draft"     // Черновик, виден только владельцу
+	StatusPublished VacancyStatus = "published" // Опубликована, видна всем
+	StatusClosed    VacancyStatus = "closed"    // Закрыта, отклики не принимаются
+)
+
+// CanTransitionTo проверяет, допустим ли переход в указанное состояние
+func (s VacancyStatus) CanTransitionTo(next VacancyStatus) bool {
+	switch s {
+	case StatusDraft:
+		return next == StatusPublished
+	case StatusPublished:
+		return next == StatusClosed
+	case StatusClosed:
+		return next == StatusPublished // Повторная публикация закрытой вакансии
+	}
+	return false
+}
+
+// EmploymentType определяет тип занятости
+type EmploymentType string
+
+const (
+	EmploymentFullTime   EmploymentType = "full_time"  // Полная занятость
+	EmploymentPartTime   EmploymentType = "part_time"  // Частичная занятость
+	EmploymentInternship EmploymentType = "internship" // Стажировка
+	EmploymentContract   EmploymentType = "contract"   // Проектная работа
+)
+
+// Vacancy представляет модель вакансии работодателя
+type Vacancy struct {
+	ID             uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	EmployerID     uuid.UUID      `gorm:"type:uuid;index;not null" json:"employerId"`
+	Title          string         `gorm:"type:varchar(255);not null" json:"title"`
+	Description    string         `gorm:"type:text;not null" json:"description"`
+	Requirements   string         `gorm:"type:text" json:"requirements"`
+	Location       string         `gorm:"type:varchar(255)" json:"location"`
+	EmploymentType EmploymentType `gorm:"type:varchar(32);not null;default:'full_time'" json:"employmentType"`
+	SalaryFrom     *int           `json:"salaryFrom"`
+	SalaryTo       *int           `json:"salaryTo"`
+	Currency       string         `gorm:"type:varchar(3);not null;default:'KZT'" json:"currency"`
+	Status         VacancyStatus  `gorm:"type:varchar(16);index;not null;default:'draft'" json:"status"`
+	PublishedAt    *time.Time     `json:"publishedAt"`
+	ClosedAt       *time.Time     `json:"closedAt"`
+	CreatedAt      time.Time      `gorm:"autoCreateTime" json:"createdAt"`
+	UpdatedAt      time.Time      `gorm:"autoUpdateTime" json:"updatedAt"`
+}
+
+// TableName возвращает имя таблицы для модели Vacancy
+func (Vacancy) TableName() string {
+	return "vacancies"
+}
+
+// BeforeCreate выполняется перед созданием записи
+func (v *Vacancy) BeforeCreate(tx *gorm.DB) error {
+	// Генерация UUID если не задан
+	if v.ID == uuid.Nil {
+		v.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"vacancy-service/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Ошибки репозитория откликов
+var (
+	ErrApplicationNotFound      = errors.New("отклик не найден")
+	ErrApplicationAlreadyExists = errors.New("студент уже откликался на эту вакансию")
+	ErrApplicationStatusChanged = errors.New("статус отклика был изменён параллельно")
+)
+
+// ApplicationFilter содержит условия выборки откликов
+type ApplicationFilter struct {
+	StudentID  uuid.UUID
+	EmployerID uuid.UUID
+	VacancyID  uuid.UUID
+	Status     models.ApplicationStatus
+	Offset     int
+	Limit      int
+}
+
+// ApplicationRepository определяет интерфейс для работы с откликами в БД
+type ApplicationRepository interface {
+	Create(application *models.Application, change *models.ApplicationStatusChange) error
+	FindByID(id uuid.UUID) (*models.Application, error)
+	List(filter ApplicationFilter) ([]models.Application, int64, error)
+	ChangeStatus(application *models.Application, change *models.ApplicationStatusChange) error
+}
+
+// applicationRepository реализует ApplicationRepository
+type applicationRepository struct {
+	db *gorm.DB
+}
+
+// NewApplicationRepository создаёт новый экземпляр репозитория откликов
+func NewApplicationRepository(db *gorm.DB) ApplicationRepository {
+	return &applicationRepository{db: db}
+}
+
+// Create создаёт отклик и первую запись истории в одной транзакции
+func (r *applicationRepository) Create(application *models.Application, change *models.ApplicationStatusChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("History").Create(application).Error; err != nil {
+			// Повторный отклик перехватывается уникальным индексом (vacancy_id, student_id)
+			if strings.Contains(err.Error(), "duplicate key") {
+				return ErrApplicationAlreadyExists
+			}
+			return err
+		}
+
+		change.ApplicationID = application.ID
+		if err := tx.Create(change).Error; err != nil {
+			return err
+		}
+
+		application.History = []models.ApplicationStatusChange{*change}
+		return nil
+	})
+}
+
+// FindByID находит отклик по UUID вместе с историей статусов
+func (r *applicationRepository) FindByID(id uuid.UUID) (*models.Application, error) {
+	var application models.Application
+	err := r.db.
+		Preload("History", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at ASC, id ASC")
+		}).
+		Where("id = ?", id).
+		First(&application).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrApplicationNotFound
+		}
+		return nil, err
+	}
+	return &application, nil
+}
+
+// List возвращает страницу откликов, удовлетворяющих фильтру, и их общее количество
+func (r *applicationRepository) List(filter ApplicationFilter) ([]models.Application, int64, error) {
+	query := r.db.Model(&models.Application{})
+
+	if filter.StudentID != uuid.Nil {
+		query = query.Where("student_id = ?", filter.StudentID)
+	}
+	if filter.EmployerID != uuid.Nil {
+		query = query.Where("employer_id = ?", filter.EmployerID)
+	}
+	if filter.VacancyID != uuid.Nil {
+		query = query.Where("vacancy_id = ?", filter.VacancyID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	applications := make([]models.Application, 0)
+	err := query.
+		Order("created_at DESC").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&applications).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return applications, total, nil
+}
+
+// ChangeStatus меняет статус отклика и добавляет запись в историю.
+// Обновление выполняется только если статус не изменился с момента чтения.
+func (r *applicationRepository) ChangeStatus(application *models.Application, change *models.ApplicationStatusChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Application{}).
+			Where("id = ? AND status = ?", application.ID, change.FromStatus).
+			Update("status", change.ToStatus)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrApplicationStatusChanged
+		}
+
+		change.ApplicationID = application.ID
+		if err := tx.Create(change).Error; err != nil {
+			return err
+		}
+
+		application.Status = change.ToStatus
+		application.History = append(application.History, *change)
+		return nil
+	})
+}
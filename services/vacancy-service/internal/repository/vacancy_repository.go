@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"errors"
+	"vacancy-service/internal/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Ошибки репозитория
+var (
+	ErrVacancyNotFound = errors.New("вакансия не найдена")
+)
+
+// VacancyFilter содержит условия выборки вакансий
+type VacancyFilter struct {
+	Status         models.VacancyStatus
+	EmployerID     uuid.UUID
+	EmployerIDs    []uuid.UUID // Допустимые работодатели; nil - без ограничения
+	EmploymentType models.EmploymentType
+	Location       string
+	Search         string
+	Offset         int
+	Limit          int
+}
+
+// VacancyRepository определяет интерфейс для работы с вакансиями в БД
+type VacancyRepository interface {
+	Create(vacancy *models.Vacancy) error
+	FindByID(id uuid.UUID) (*models.Vacancy, error)
+	Update(vacancy *models.Vacancy) error
+	Delete(id uuid.UUID) error
+	List(filter VacancyFilter) ([]models.Vacancy, int64, error)
+}
+
+// vacancyRepository реализует VacancyRepository
+type vacancyRepository struct {
+	db *gorm.DB
+}
+
+// NewVacancyRepository создаёт новый экземпляр репозитория вакансий
+func NewVacancyRepository(db *gorm.DB) VacancyRepository {
+	return &vacancyRepository{db: db}
+}
+
+// Create создаёт новую вакансию в базе данных
+func (r *vacancyRepository) Create(vacancy *models.Vacancy) error {
+	return r.db.Create(vacancy).Error
+}
+
+// FindByID находит вакансию по UUID
+func (r *vacancyRepository) FindByID(id uuid.UUID) (*models.Vacancy, error) {
+	var vacancy models.Vacancy
+	if err := r.db.Where("id = ?", id).First(&vacancy).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrVacancyNotFound
+		}
+		return nil, err
+	}
+	return &vacancy, nil
+}
+
+// Update обновляет данные вакансии
+func (r *vacancyRepository) Update(vacancy *models.Vacancy) error {
+	result := r.db.Save(vacancy)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrVacancyNotFound
+	}
+	return nil
+}
+
+// Delete удаляет вакансию по UUID
+func (r *vacancyRepository) Delete(id uuid.UUID) error {
+	result := r.db.Delete(&models.Vacancy{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrVacancyNotFound
+	}
+	return nil
+}
+
+// List возвращает страницу вакансий, удовлетворяющих фильтру, и их общее количество
+func (r *vacancyRepository) List(filter VacancyFilter) ([]models.Vacancy, int64, error) {
+	query := r.db.Model(&models.Vacancy{})
+
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.EmployerID != uuid.Nil {
+		query = query.Where("employer_id = ?", filter.EmployerID)
+	}
+	if filter.EmployerIDs != nil {
+		query = query.Where("employer_id IN ?", filter.EmployerIDs)
+	}
+	if filter.EmploymentType != "" {
+		query = query.Where("employment_type = ?", filter.EmploymentType)
+	}
+	if filter.Location != "" {
+		query = query.Where("location ILIKE ?", "%"+filter.Location+"%")
+	}
+	if filter.Search != "" {
+		pattern := "%" + filter.Search + "%"
+		query = query.Where("title ILIKE ? OR description ILIKE ?", pattern, pattern)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	vacancies := make([]models.Vacancy, 0)
+	err := query.
+		Order("created_at DESC").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&vacancies).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return vacancies, total, nil
+}
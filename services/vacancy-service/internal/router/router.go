@@ -0,0 +1,102 @@
+package router
+
+import (
+	"net/http"
+	"observability/health"
+	"vacancy-service/internal/dto"
+	"vacancy-service/internal/handler"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SetupRouter настраивает и возвращает роутер Gin
+func SetupRouter(vacancyHandler *handler.VacancyHandler, applicationHandler *handler.ApplicationHandler, healthHandler *health.Handler) *gin.Engine {
+	// Создание роутера с стандартными middleware (Logger и Recovery)
+	r := gin.Default()
+
+	// Группа маршрутов вакансий (пользователь аутентифицирован API Gateway)
+	vacancies := r.Group("/api/vacancies")
+	vacancies.Use(userMiddleware())
+	{
+		vacancies.GET("", vacancyHandler.List)
+		vacancies.GET("/my", requireRoles("employer"), vacancyHandler.ListOwn)
+		vacancies.GET("/:id", vacancyHandler.Get)
+
+		// Управление вакансиями доступно только работодателю-владельцу
+		manage := vacancies.Group("")
+		manage.Use(requireRoles("employer"))
+		{
+			manage.POST("", vacancyHandler.Create)
+			manage.PUT("/:id", vacancyHandler.Update)
+			manage.DELETE("/:id", vacancyHandler.Delete)
+			manage.POST("/:id/publish", vacancyHandler.Publish)
+			manage.POST("/:id/close", vacancyHandler.Close)
+		}
+	}
+
+	// Группа маршрутов откликов (пользователь аутентифицирован API Gateway)
+	applications := r.Group("/api/applications")
+	applications.Use(userMiddleware())
+	{
+		// Студент откликается, просматривает и отзывает свои отклики
+		applications.POST("", requireRoles("student"), applicationHandler.Apply)
+		applications.GET("/my", requireRoles("student"), applicationHandler.ListOwn)
+		applications.POST("/:id/withdraw", requireRoles("student"), applicationHandler.Withdraw)
+
+		// Работодатель ведёт отклики на свои вакансии по воронке
+		applications.GET("", requireRoles("employer"), applicationHandler.ListForEmployer)
+		applications.POST("/:id/status", requireRoles("employer"), applicationHandler.ChangeStatus)
+
+		// Отклик доступен обеим сторонам
+		applications.GET("/:id", applicationHandler.Get)
+	}
+
+	// Проверки состояния: живость процесса и готовность (база данных доступна, сервер не останавливается).
+	// /health оставлен для совместимости и равносилен /health/ready
+	r.GET("/health", healthHandler.Ready)
+	r.GET("/health/live", healthHandler.Live)
+	r.GET("/health/ready", healthHandler.Ready)
+
+	return r
+}
+
+// userMiddleware извлекает ID, роль и email пользователя из заголовков X-User-ID, X-User-Role и X-User-Email,
+// которые API Gateway устанавливает после проверки JWT токена
+func userMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := uuid.Parse(c.GetHeader("X-User-ID"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.ErrorResponse{
+				Error:   "Не авторизован",
+				Message: "Отсутствует или некорректен заголовок X-User-ID",
+			})
+			return
+		}
+
+		// Сохранение данных пользователя в контексте запроса
+		c.Set("user_id", userID)
+		c.Set("user_role", c.GetHeader("X-User-Role"))
+		c.Set("user_email", c.GetHeader("X-User-Email"))
+
+		c.Next()
+	}
+}
+
+// requireRoles пропускает только пользователей с одной из указанных ролей
+func requireRoles(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := c.GetString("user_role")
+		for _, allowed := range roles {
+			if role == allowed {
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "Доступ запрещён",
+			Message: "Недостаточно прав для выполнения операции",
+		})
+	}
+}
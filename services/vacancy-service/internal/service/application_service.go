@@ -0,0 +1,220 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"vacancy-service/internal/client"
+	"vacancy-service/internal/dto"
+	"vacancy-service/internal/models"
+	"vacancy-service/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// Ошибки сервиса откликов
+var (
+	ErrVacancyNotOpen               = errors.New("вакансия не принимает отклики")
+	ErrNotResumeOwner               = errors.New("резюме принадлежит другому студенту")
+	ErrNotApplicationParticipant    = errors.New("отклик недоступен текущему пользователю")
+	ErrInvalidApplicationTransition = errors.New("недопустимый переход статуса отклика")
+)
+
+// ApplicationService определяет интерфейс сервиса откликов
+type ApplicationService interface {
+	Apply(studentID uuid.UUID, req *dto.ApplyRequest) (*models.Application, error)
+	Get(callerID, applicationID uuid.UUID) (*models.Application, error)
+	ListOwn(studentID uuid.UUID, page, pageSize int) (*dto.ApplicationListResponse, error)
+	ListForEmployer(employerID uuid.UUID, query *dto.ListApplicationsQuery) (*dto.ApplicationListResponse, error)
+	ChangeStatus(employerID, applicationID uuid.UUID, req *dto.ChangeStatusRequest) (*models.Application, error)
+	Withdraw(studentID, applicationID uuid.UUID, comment string) (*models.Application, error)
+}
+
+// applicationService реализует ApplicationService
+type applicationService struct {
+	applicationRepo repository.ApplicationRepository
+	vacancyRepo     repository.VacancyRepository
+	studentClient   client.StudentClient
+	employerClient  client.EmployerClient
+	pipeline        *models.Pipeline
+}
+
+// NewApplicationService создаёт новый экземпляр сервиса откликов
+func NewApplicationService(
+	applicationRepo repository.ApplicationRepository,
+	vacancyRepo repository.VacancyRepository,
+	studentClient client.StudentClient,
+	employerClient client.EmployerClient,
+	pipeline *models.Pipeline,
+) ApplicationService {
+	return &applicationService{
+		applicationRepo: applicationRepo,
+		vacancyRepo:     vacancyRepo,
+		studentClient:   studentClient,
+		employerClient:  employerClient,
+		pipeline:        pipeline,
+	}
+}
+
+// Apply создаёт отклик студента на опубликованную вакансию с копией резюме
+func (s *applicationService) Apply(studentID uuid.UUID, req *dto.ApplyRequest) (*models.Application, error) {
+	vacancyID, err := uuid.Parse(req.VacancyID)
+	if err != nil {
+		return nil, err
+	}
+	resumeID, err := uuid.Parse(req.ResumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	vacancy, err := s.vacancyRepo.FindByID(vacancyID)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy.Status != models.StatusPublished {
+		return nil, ErrVacancyNotOpen
+	}
+	// Компания могла утратить подтверждение после публикации
+	verified, err := s.employerClient.IsVerified(vacancy.EmployerID)
+	if err != nil {
+		return nil, err
+	}
+	if !verified {
+		return nil, ErrVacancyNotOpen
+	}
+
+	// Резюме копируется, чтобы работодатель видел его в том виде, в котором оно было отправлено
+	resume, err := s.studentClient.GetResume(resumeID)
+	if err != nil {
+		return nil, err
+	}
+	if resume.StudentID != studentID {
+		return nil, ErrNotResumeOwner
+	}
+
+	application := &models.Application{
+		VacancyID:      vacancy.ID,
+		StudentID:      studentID,
+		EmployerID:     vacancy.EmployerID,
+		ResumeID:       resumeID,
+		ResumeSnapshot: resume.Raw,
+		CoverLetter:    strings.TrimSpace(req.CoverLetter),
+		Status:         models.ApplicationSubmitted,
+	}
+	change := &models.ApplicationStatusChange{
+		ToStatus:  models.ApplicationSubmitted,
+		ChangedBy: studentID,
+	}
+
+	if err := s.applicationRepo.Create(application, change); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+// Get возвращает отклик с историей статусов студенту-автору или работодателю
+func (s *applicationService) Get(callerID, applicationID uuid.UUID) (*models.Application, error) {
+	application, err := s.applicationRepo.FindByID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if application.StudentID != callerID && application.EmployerID != callerID {
+		return nil, ErrNotApplicationParticipant
+	}
+	return application, nil
+}
+
+// ListOwn возвращает отклики студента
+func (s *applicationService) ListOwn(studentID uuid.UUID, page, pageSize int) (*dto.ApplicationListResponse, error) {
+	page, pageSize = normalizePage(page, pageSize)
+
+	return s.list(repository.ApplicationFilter{
+		StudentID: studentID,
+		Offset:    (page - 1) * pageSize,
+		Limit:     pageSize,
+	}, page, pageSize)
+}
+
+// ListForEmployer возвращает отклики на вакансии работодателя
+func (s *applicationService) ListForEmployer(employerID uuid.UUID, query *dto.ListApplicationsQuery) (*dto.ApplicationListResponse, error) {
+	page, pageSize := normalizePage(query.Page, query.PageSize)
+
+	filter := repository.ApplicationFilter{
+		EmployerID: employerID,
+		Status:     query.Status,
+		Offset:     (page - 1) * pageSize,
+		Limit:      pageSize,
+	}
+	if query.VacancyID != "" {
+		vacancyID, err := uuid.Parse(query.VacancyID)
+		if err != nil {
+			return nil, err
+		}
+		filter.VacancyID = vacancyID
+	}
+
+	return s.list(filter, page, pageSize)
+}
+
+// ChangeStatus переводит отклик на следующий этап воронки найма
+func (s *applicationService) ChangeStatus(employerID, applicationID uuid.UUID, req *dto.ChangeStatusRequest) (*models.Application, error) {
+	application, err := s.applicationRepo.FindByID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if application.EmployerID != employerID {
+		return nil, ErrNotApplicationParticipant
+	}
+
+	if !s.pipeline.CanTransition(application.Status, req.Status) {
+		return nil, ErrInvalidApplicationTransition
+	}
+
+	return s.changeStatus(application, employerID, req.Status, req.Comment)
+}
+
+// Withdraw отзывает отклик студента, если он ещё не завершён
+func (s *applicationService) Withdraw(studentID, applicationID uuid.UUID, comment string) (*models.Application, error) {
+	application, err := s.applicationRepo.FindByID(applicationID)
+	if err != nil {
+		return nil, err
+	}
+	if application.StudentID != studentID {
+		return nil, ErrNotApplicationParticipant
+	}
+
+	if application.Status.IsFinal() {
+		return nil, ErrInvalidApplicationTransition
+	}
+
+	return s.changeStatus(application, studentID, models.ApplicationWithdrawn, comment)
+}
+
+// changeStatus сохраняет новый статус отклика с записью в истории
+func (s *applicationService) changeStatus(application *models.Application, changedBy uuid.UUID, next models.ApplicationStatus, comment string) (*models.Application, error) {
+	change := &models.ApplicationStatusChange{
+		FromStatus: application.Status,
+		ToStatus:   next,
+		ChangedBy:  changedBy,
+		Comment:    strings.TrimSpace(comment),
+	}
+
+	if err := s.applicationRepo.ChangeStatus(application, change); err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+// list выполняет выборку и формирует ответ со страницей откликов
+func (s *applicationService) list(filter repository.ApplicationFilter, page, pageSize int) (*dto.ApplicationListResponse, error) {
+	applications, total, err := s.applicationRepo.List(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ApplicationListResponse{
+		Items:    applications,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"vacancy-service/internal/client"
+	"vacancy-service/internal/dto"
+	"vacancy-service/internal/models"
+	"vacancy-service/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// Параметры пагинации по умолчанию
+const (
+	defaultPageSize = 20
+	defaultCurrency = "KZT"
+)
+
+// Ошибки сервиса вакансий
+var (
+	ErrNotVacancyOwner           = errors.New("вакансия принадлежит другому работодателю")
+	ErrInvalidStatusTransition   = errors.New("недопустимый переход состояния вакансии")
+	ErrInvalidSalaryRange        = errors.New("минимальная зарплата больше максимальной")
+	ErrClosedVacancyModification = errors.New("закрытую вакансию нельзя редактировать")
+	ErrEmployerNotVerified       = errors.New("компания работодателя не прошла проверку")
+)
+
+// VacancyService определяет интерфейс сервиса вакансий
+type VacancyService interface {
+	Create(employerID uuid.UUID, req *dto.VacancyRequest) (*models.Vacancy, error)
+	Get(callerID, vacancyID uuid.UUID) (*models.Vacancy, error)
+	ListPublished(query *dto.ListVacanciesQuery) (*dto.VacancyListResponse, error)
+	ListOwn(employerID uuid.UUID, page, pageSize int) (*dto.VacancyListResponse, error)
+	Update(employerID, vacancyID uuid.UUID, req *dto.VacancyRequest) (*models.Vacancy, error)
+	Delete(employerID, vacancyID uuid.UUID) error
+	Publish(employerID, vacancyID uuid.UUID) (*models.Vacancy, error)
+	Close(employerID, vacancyID uuid.UUID) (*models.Vacancy, error)
+}
+
+// vacancyService реализует VacancyService
+type vacancyService struct {
+	vacancyRepo    repository.VacancyRepository
+	employerClient client.EmployerClient
+}
+
+// NewVacancyService создаёт новый экземпляр сервиса вакансий
+func NewVacancyService(vacancyRepo repository.VacancyRepository, employerClient client.EmployerClient) VacancyService {
+	return &vacancyService{
+		vacancyRepo:    vacancyRepo,
+		employerClient: employerClient,
+	}
+}
+
+// Create создаёт новую вакансию в состоянии черновика
+func (s *vacancyService) Create(employerID uuid.UUID, req *dto.VacancyRequest) (*models.Vacancy, error) {
+	vacancy := &models.Vacancy{
+		EmployerID: employerID,
+		Status:     models.StatusDraft,
+	}
+	if err := applyVacancyRequest(vacancy, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.vacancyRepo.Create(vacancy); err != nil {
+		return nil, err
+	}
+	return vacancy, nil
+}
+
+// Get возвращает вакансию. Черновики доступны только владельцу, а вакансии компании,
+// которая сейчас не подтверждена (проверка отозвана или данные изменены), скрыты от остальных.
+func (s *vacancyService) Get(callerID, vacancyID uuid.UUID) (*models.Vacancy, error) {
+	vacancy, err := s.vacancyRepo.FindByID(vacancyID)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy.EmployerID == callerID {
+		return vacancy, nil
+	}
+
+	// Для постороннего скрытой вакансии не существует
+	if vacancy.Status == models.StatusDraft {
+		return nil, repository.ErrVacancyNotFound
+	}
+	verified, err := s.employerClient.IsVerified(vacancy.EmployerID)
+	if err != nil {
+		return nil, err
+	}
+	if !verified {
+		return nil, repository.ErrVacancyNotFound
+	}
+	return vacancy, nil
+}
+
+// ListPublished возвращает опубликованные вакансии с фильтрами и пагинацией.
+// Подтверждение компании проверяется на момент запроса: вакансии компании,
+// утратившей его после публикации, в выдачу не попадают.
+func (s *vacancyService) ListPublished(query *dto.ListVacanciesQuery) (*dto.VacancyListResponse, error) {
+	page, pageSize := normalizePage(query.Page, query.PageSize)
+
+	verified, err := s.employerClient.VerifiedEmployers()
+	if err != nil {
+		return nil, err
+	}
+
+	filter := repository.VacancyFilter{
+		Status:         models.StatusPublished,
+		EmployerIDs:    verified,
+		EmploymentType: query.EmploymentType,
+		Location:       strings.TrimSpace(query.Location),
+		Search:         strings.TrimSpace(query.Search),
+		Offset:         (page - 1) * pageSize,
+		Limit:          pageSize,
+	}
+	if query.EmployerID != "" {
+		employerID, err := uuid.Parse(query.EmployerID)
+		if err != nil {
+			return nil, err
+		}
+		filter.EmployerID = employerID
+	}
+
+	return s.list(filter, page, pageSize)
+}
+
+// ListOwn возвращает все вакансии работодателя независимо от состояния
+func (s *vacancyService) ListOwn(employerID uuid.UUID, page, pageSize int) (*dto.VacancyListResponse, error) {
+	page, pageSize = normalizePage(page, pageSize)
+
+	return s.list(repository.VacancyFilter{
+		EmployerID: employerID,
+		Offset:     (page - 1) * pageSize,
+		Limit:      pageSize,
+	}, page, pageSize)
+}
+
+// Update обновляет вакансию владельца
+func (s *vacancyService) Update(employerID, vacancyID uuid.UUID, req *dto.VacancyRequest) (*models.Vacancy, error) {
+	vacancy, err := s.findOwned(employerID, vacancyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if vacancy.Status == models.StatusClosed {
+		return nil, ErrClosedVacancyModification
+	}
+
+	if err := applyVacancyRequest(vacancy, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.vacancyRepo.Update(vacancy); err != nil {
+		return nil, err
+	}
+	return vacancy, nil
+}
+
+// Delete удаляет вакансию владельца
+func (s *vacancyService) Delete(employerID, vacancyID uuid.UUID) error {
+	if _, err := s.findOwned(employerID, vacancyID); err != nil {
+		return err
+	}
+	return s.vacancyRepo.Delete(vacancyID)
+}
+
+// Publish публикует черновик или повторно открывает закрытую вакансию.
+// Публикация доступна только работодателям с подтверждённым БИН компании.
+func (s *vacancyService) Publish(employerID, vacancyID uuid.UUID) (*models.Vacancy, error) {
+	verified, err := s.employerClient.IsVerified(employerID)
+	if err != nil {
+		return nil, err
+	}
+	if !verified {
+		return nil, ErrEmployerNotVerified
+	}
+
+	return s.transition(employerID, vacancyID, models.StatusPublished)
+}
+
+// Close закрывает опубликованную вакансию
+func (s *vacancyService) Close(employerID, vacancyID uuid.UUID) (*models.Vacancy, error) {
+	return s.transition(employerID, vacancyID, models.StatusClosed)
+}
+
+// transition переводит вакансию владельца в новое состояние
+func (s *vacancyService) transition(employerID, vacancyID uuid.UUID, next models.VacancyStatus) (*models.Vacancy, error) {
+	vacancy, err := s.findOwned(employerID, vacancyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !vacancy.Status.CanTransitionTo(next) {
+		return nil, ErrInvalidStatusTransition
+	}
+
+	now := time.Now()
+	vacancy.Status = next
+	switch next {
+	case models.StatusPublished:
+		vacancy.PublishedAt = &now
+		vacancy.ClosedAt = nil
+	case models.StatusClosed:
+		vacancy.ClosedAt = &now
+	}
+
+	if err := s.vacancyRepo.Update(vacancy); err != nil {
+		return nil, err
+	}
+	return vacancy, nil
+}
+
+// findOwned загружает вакансию и проверяет, что она принадлежит работодателю
+func (s *vacancyService) findOwned(employerID, vacancyID uuid.UUID) (*models.Vacancy, error) {
+	vacancy, err := s.vacancyRepo.FindByID(vacancyID)
+	if err != nil {
+		return nil, err
+	}
+	if vacancy.EmployerID != employerID {
+		return nil, ErrNotVacancyOwner
+	}
+	return vacancy, nil
+}
+
+// list выполняет выборку и формирует ответ со страницей вакансий
+func (s *vacancyService) list(filter repository.VacancyFilter, page, pageSize int) (*dto.VacancyListResponse, error) {
+	vacancies, total, err := s.vacancyRepo.List(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.VacancyListResponse{
+		Items:    vacancies,
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// applyVacancyRequest переносит поля запроса в модель вакансии
+func applyVacancyRequest(vacancy *models.Vacancy, req *dto.VacancyRequest) error {
+	if req.SalaryFrom != nil && req.SalaryTo != nil && *req.SalaryFrom > *req.SalaryTo {
+		return ErrInvalidSalaryRange
+	}
+
+	vacancy.Title = strings.TrimSpace(req.Title)
+	vacancy.Description = req.Description
+	vacancy.Requirements = req.Requirements
+	vacancy.Location = strings.TrimSpace(req.Location)
+	vacancy.SalaryFrom = req.SalaryFrom
+	vacancy.SalaryTo = req.SalaryTo
+
+	vacancy.EmploymentType = req.EmploymentType
+	if vacancy.EmploymentType == "" {
+		vacancy.EmploymentType = models.EmploymentFullTime
+	}
+
+	vacancy.Currency = strings.ToUpper(req.Currency)
+	if vacancy.Currency == "" {
+		vacancy.Currency = defaultCurrency
+	}
+	return nil
+}
+
+// normalizePage подставляет значения пагинации по умолчанию
+func normalizePage(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	return page, pageSize
+}
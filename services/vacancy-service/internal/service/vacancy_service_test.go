@@ -0,0 +1,142 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"vacancy-service/internal/client"
+	"vacancy-service/internal/dto"
+	"vacancy-service/internal/models"
+	"vacancy-service/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// memoryVacancyRepository - вакансии в памяти; List применяет фильтр по статусу и работодателям
+type memoryVacancyRepository struct {
+	repository.VacancyRepository
+	vacancies map[uuid.UUID]*models.Vacancy
+}
+
+func (r *memoryVacancyRepository) FindByID(id uuid.UUID) (*models.Vacancy, error) {
+	if vacancy, ok := r.vacancies[id]; ok {
+		return vacancy, nil
+	}
+	return nil, repository.ErrVacancyNotFound
+}
+
+func (r *memoryVacancyRepository) List(filter repository.VacancyFilter) ([]models.Vacancy, int64, error) {
+	allowed := make(map[uuid.UUID]bool, len(filter.EmployerIDs))
+	for _, id := range filter.EmployerIDs {
+		allowed[id] = true
+	}
+
+	vacancies := make([]models.Vacancy, 0)
+	for _, vacancy := range r.vacancies {
+		if filter.Status != "" && vacancy.Status != filter.Status {
+			continue
+		}
+		if filter.EmployerIDs != nil && !allowed[vacancy.EmployerID] {
+			continue
+		}
+		vacancies = append(vacancies, *vacancy)
+	}
+	return vacancies, int64(len(vacancies)), nil
+}
+
+// fakeEmployerClient - текущее состояние проверки компаний работодателей
+type fakeEmployerClient struct {
+	verified map[uuid.UUID]bool
+	err      error
+}
+
+func (c *fakeEmployerClient) IsVerified(userID uuid.UUID) (bool, error) {
+	return c.verified[userID], c.err
+}
+
+func (c *fakeEmployerClient) VerifiedEmployers() ([]uuid.UUID, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	userIDs := []uuid.UUID{}
+	for id, verified := range c.verified {
+		if verified {
+			userIDs = append(userIDs, id)
+		}
+	}
+	return userIDs, nil
+}
+
+// verificationTestEnv - опубликованные вакансии подтверждённого и утратившего подтверждение работодателей
+type verificationTestEnv struct {
+	service   *vacancyService
+	employers *fakeEmployerClient
+	verified  *models.Vacancy
+	revoked   *models.Vacancy
+}
+
+func newVerificationTestEnv() *verificationTestEnv {
+	verified := &models.Vacancy{ID: uuid.New(), EmployerID: uuid.New(), Status: models.StatusPublished}
+	revoked := &models.Vacancy{ID: uuid.New(), EmployerID: uuid.New(), Status: models.StatusPublished}
+	employers := &fakeEmployerClient{verified: map[uuid.UUID]bool{verified.EmployerID: true, revoked.EmployerID: false}}
+	repo := &memoryVacancyRepository{vacancies: map[uuid.UUID]*models.Vacancy{verified.ID: verified, revoked.ID: revoked}}
+
+	return &verificationTestEnv{
+		service:   &vacancyService{vacancyRepo: repo, employerClient: employers},
+		employers: employers,
+		verified:  verified,
+		revoked:   revoked,
+	}
+}
+
+func TestListPublishedHidesUnverifiedEmployers(t *testing.T) {
+	env := newVerificationTestEnv()
+
+	list, err := env.service.ListPublished(&dto.ListVacanciesQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Total != 1 || len(list.Items) != 1 || list.Items[0].ID != env.verified.ID {
+		t.Errorf("items = %+v, want only the vacancy of the verified employer", list.Items)
+	}
+
+	// Без ответа employer-service выдача не формируется
+	env.employers.err = client.ErrEmployerServiceUnavailable
+	if _, err := env.service.ListPublished(&dto.ListVacanciesQuery{}); !errors.Is(err, client.ErrEmployerServiceUnavailable) {
+		t.Errorf("error = %v, want %v", err, client.ErrEmployerServiceUnavailable)
+	}
+}
+
+func TestGetHidesUnverifiedEmployers(t *testing.T) {
+	env := newVerificationTestEnv()
+	student := uuid.New()
+
+	tests := []struct {
+		name    string
+		caller  uuid.UUID
+		vacancy *models.Vacancy
+		wantErr error
+	}{
+		{name: "verified employer", caller: student, vacancy: env.verified},
+		{name: "verification revoked", caller: student, vacancy: env.revoked, wantErr: repository.ErrVacancyNotFound},
+		{name: "owner after revocation", caller: env.revoked.EmployerID, vacancy: env.revoked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := env.service.Get(tt.caller, tt.vacancy.ID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Get error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestApplyRequiresVerifiedEmployer(t *testing.T) {
+	env := newVerificationTestEnv()
+	applications := &applicationService{vacancyRepo: env.service.vacancyRepo, employerClient: env.employers}
+
+	_, err := applications.Apply(uuid.New(), &dto.ApplyRequest{VacancyID: env.revoked.ID.String(), ResumeID: uuid.NewString()})
+	if !errors.Is(err, ErrVacancyNotOpen) {
+		t.Errorf("Apply error = %v, want %v", err, ErrVacancyNotOpen)
+	}
+}
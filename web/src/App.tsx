@@ -1,8 +1,21 @@
+import { useState } from 'react';
 import { Link, Outlet } from 'react-router-dom';
 import { useAuth } from './context';
+import { authApi } from './api';
 
 const App = () => {
-  const { isAuthenticated, user, logout } = useAuth();
+  const { isAuthenticated, user, accessToken, logout } = useAuth();
+  const [resendMessage, setResendMessage] = useState('');
+
+  const handleResend = async () => {
+    if (!accessToken) return;
+    try {
+      const response = await authApi.resendVerification(accessToken);
+      setResendMessage(response.message);
+    } catch (err) {
+      setResendMessage(err instanceof Error ? err.message : 'Failed to send email');
+    }
+  };
 
   return (
     <div className="min-h-screen bg-gray-50">
@@ -51,6 +64,16 @@ const App = () => {
           </div>
         </div>
       </header>
+      {isAuthenticated && user && !user.email_verified && (
+        <div className="bg-yellow-50 border-b border-yellow-200">
+          <div className="max-w-7xl mx-auto py-2 px-4 sm:px-6 lg:px-8 flex items-center justify-between text-sm text-yellow-800">
+            <span>{resendMessage || 'Confirm your email to apply, publish vacancies and edit data.'}</span>
+            <button onClick={handleResend} className="font-medium underline hover:text-yellow-900">
+              Resend email
+            </button>
+          </div>
+        </div>
+      )}
       <main className="max-w-7xl mx-auto py-6 sm:px-6 lg:px-8">
         <Outlet />
       </main>
@@ -1,5 +1,5 @@
 import { apiClient } from './client';
-import type { LoginRequest, RegisterRequest, AuthResponse, User, TokenResponse, RoleProfile } from '../types/auth';
+import type { LoginRequest, RegisterRequest, AuthResponse, User, TokenResponse, RoleProfile, MfaSetupResponse, OidcProvider } from '../types/auth';
 
 // Refresh token request type
 interface RefreshRequest {
@@ -14,6 +14,36 @@ export const authApi = {
     });
   },
 
+  async verifyMfaLogin(challengeToken: string, code: string, recoveryCode?: string): Promise<AuthResponse> {
+    return apiClient.request<AuthResponse>('/api/auth/login/mfa', {
+      method: 'POST',
+      body: JSON.stringify({ challenge_token: challengeToken, code, recovery_code: recoveryCode }),
+    });
+  },
+
+  async setupMfaForLogin(challengeToken: string): Promise<MfaSetupResponse> {
+    return apiClient.request<MfaSetupResponse>('/api/auth/login/mfa/setup', {
+      method: 'POST',
+      body: JSON.stringify({ challenge_token: challengeToken }),
+    });
+  },
+
+  async listOidcProviders(): Promise<OidcProvider[]> {
+    return apiClient.request<OidcProvider[]>('/api/auth/oidc/providers');
+  },
+
+  // Full-page navigation target: the backend redirects to the provider's login page
+  oidcLoginUrl(provider: string): string {
+    return `${apiClient.baseURL}/api/auth/oidc/${encodeURIComponent(provider)}/login`;
+  },
+
+  async exchangeOidcCode(code: string): Promise<AuthResponse> {
+    return apiClient.request<AuthResponse>('/api/auth/oidc/exchange', {
+      method: 'POST',
+      body: JSON.stringify({ code }),
+    });
+  },
+
   async register(data: RegisterRequest): Promise<AuthResponse> {
     return apiClient.request<AuthResponse>('/api/auth/register', {
       method: 'POST',
@@ -36,6 +66,55 @@ export const authApi = {
     });
   },
 
+  async verifyEmail(token: string): Promise<User> {
+    return apiClient.request<User>('/api/auth/verify-email', {
+      method: 'POST',
+      body: JSON.stringify({ token }),
+    });
+  },
+
+  async resendVerification(token: string): Promise<{ message: string }> {
+    return apiClient.request<{ message: string }>('/api/auth/verify-email/resend', {
+      method: 'POST',
+      headers: {
+        Authorization: `Bearer ${token}`,
+      },
+    });
+  },
+
+  async forgotPassword(email: string): Promise<{ message: string }> {
+    return apiClient.request<{ message: string }>('/api/auth/password/forgot', {
+      method: 'POST',
+      body: JSON.stringify({ email }),
+    });
+  },
+
+  async resetPassword(token: string, newPassword: string): Promise<{ message: string }> {
+    return apiClient.request<{ message: string }>('/api/auth/password/reset', {
+      method: 'POST',
+      body: JSON.stringify({ token, new_password: newPassword }),
+    });
+  },
+
+  async changePassword(token: string, currentPassword: string, newPassword: string): Promise<{ message: string }> {
+    return apiClient.request<{ message: string }>('/api/auth/password/change', {
+      method: 'POST',
+      headers: {
+        Authorization: `Bearer ${token}`,
+      },
+      body: JSON.stringify({ current_password: currentPassword, new_password: newPassword }),
+    });
+  },
+
+  async logout(token: string): Promise<void> {
+    return apiClient.request<void>('/api/auth/logout', {
+      method: 'POST',
+      headers: {
+        Authorization: `Bearer ${token}`,
+      },
+    });
+  },
+
   async updateProfile(token: string, data: RoleProfile): Promise<User> {
     return apiClient.request<User>('/api/auth/profile', {
       method: 'PUT',
@@ -60,6 +60,11 @@ export const apiClient = {
       }
     }
 
+    // No Content responses (logout, deletions) have no body to parse
+    if (response.status === 204) {
+      return undefined as T;
+    }
+
     return response.json();
   },
 };
@@ -1,3 +1,4 @@
 // API integration
 export { apiClient, ApiError } from './client';
 export { authApi } from './auth';
+export { studentsApi } from './students';
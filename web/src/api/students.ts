@@ -0,0 +1,15 @@
+import { apiClient } from './client';
+import type { StudentProfile } from '../types/auth';
+
+// Student profiles are owned by student-service (proxied by the gateway at /api/students)
+export const studentsApi = {
+  async saveProfile(token: string, data: StudentProfile): Promise<StudentProfile> {
+    return apiClient.request<StudentProfile>('/api/students/me', {
+      method: 'PUT',
+      headers: {
+        Authorization: `Bearer ${token}`,
+      },
+      body: JSON.stringify(data),
+    });
+  },
+};
@@ -0,0 +1,136 @@
+import { useEffect, useState, type FormEvent } from 'react';
+import { authApi } from '../api';
+import { useAuth } from '../context';
+import type { MfaChallenge, MfaSetupResponse } from '../types/auth';
+
+interface MfaLoginFormProps {
+  challenge: MfaChallenge;
+  onComplete: () => void;
+  onCancel: () => void;
+}
+
+// Second login step: authenticator code, recovery code, or first-time enrollment
+export const MfaLoginForm = ({ challenge, onComplete, onCancel }: MfaLoginFormProps) => {
+  const { completeMfaLogin } = useAuth();
+
+  const [code, setCode] = useState('');
+  const [useRecoveryCode, setUseRecoveryCode] = useState(false);
+  const [setup, setSetup] = useState<MfaSetupResponse | null>(null);
+  const [recoveryCodes, setRecoveryCodes] = useState<string[]>([]);
+  const [error, setError] = useState('');
+  const [isLoading, setIsLoading] = useState(false);
+
+  const needsSetup = challenge.status === 'mfa_setup_required';
+
+  useEffect(() => {
+    if (!needsSetup) return;
+    authApi
+      .setupMfaForLogin(challenge.challenge_token)
+      .then(setSetup)
+      .catch((err) => setError(err instanceof Error ? err.message : 'Failed to start two-factor setup'));
+  }, [challenge.challenge_token, needsSetup]);
+
+  const handleSubmit = async (e: FormEvent) => {
+    e.preventDefault();
+    setError('');
+    setIsLoading(true);
+
+    try {
+      const codes = useRecoveryCode
+        ? await completeMfaLogin(challenge.challenge_token, '', code)
+        : await completeMfaLogin(challenge.challenge_token, code);
+      if (codes.length > 0) {
+        setRecoveryCodes(codes);
+      } else {
+        onComplete();
+      }
+    } catch (err) {
+      setError(err instanceof Error ? err.message : 'Verification failed');
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
+  const inputClassName =
+    'w-full px-4 py-3 bg-white/10 border border-white/20 rounded-xl text-white placeholder-gray-400 focus:ring-2 focus:ring-blue-500 focus:border-transparent tracking-widest text-center';
+
+  if (recoveryCodes.length > 0) {
+    return (
+      <div className="space-y-4 text-white">
+        <h2 className="text-2xl font-bold">Save your recovery codes</h2>
+        <p className="text-blue-200/70 text-sm">
+          Each code can be used once if you lose access to your authenticator app. They will not be shown again.
+        </p>
+        <ul className="grid grid-cols-2 gap-2 font-mono bg-white/10 rounded-xl p-4">
+          {recoveryCodes.map((recoveryCode) => (
+            <li key={recoveryCode}>{recoveryCode}</li>
+          ))}
+        </ul>
+        <button
+          type="button"
+          onClick={onComplete}
+          className="w-full py-3 bg-blue-600 hover:bg-blue-700 rounded-xl font-semibold"
+        >
+          I have saved the codes
+        </button>
+      </div>
+    );
+  }
+
+  return (
+    <form onSubmit={handleSubmit} className="space-y-4 text-white">
+      <h2 className="text-2xl font-bold">Two-factor authentication</h2>
+
+      {needsSetup ? (
+        <div className="space-y-2 text-sm text-blue-200/80">
+          <p>Your role requires two-factor authentication. Add this account to your authenticator app:</p>
+          {setup && (
+            <>
+              <p className="font-mono break-all bg-white/10 rounded-lg p-3">{setup.secret}</p>
+              <a href={setup.otpauth_uri} className="text-blue-400 hover:text-blue-300">
+                Open in authenticator app
+              </a>
+            </>
+          )}
+        </div>
+      ) : (
+        <p className="text-sm text-blue-200/80">
+          {useRecoveryCode ? 'Enter one of your recovery codes.' : 'Enter the 6-digit code from your authenticator app.'}
+        </p>
+      )}
+
+      {error && <p className="text-sm text-red-300">{error}</p>}
+
+      <input
+        type="text"
+        required
+        autoFocus
+        autoComplete="one-time-code"
+        inputMode={useRecoveryCode ? 'text' : 'numeric'}
+        value={code}
+        onChange={(e) => setCode(e.target.value)}
+        placeholder={useRecoveryCode ? 'xxxx-xxxx' : '123456'}
+        className={inputClassName}
+      />
+
+      <button
+        type="submit"
+        disabled={isLoading || (needsSetup && !setup)}
+        className="w-full py-3 bg-blue-600 hover:bg-blue-700 rounded-xl font-semibold disabled:opacity-50"
+      >
+        {isLoading ? 'Verifying...' : 'Verify'}
+      </button>
+
+      <div className="flex justify-between text-sm">
+        {!needsSetup && (
+          <button type="button" onClick={() => setUseRecoveryCode(!useRecoveryCode)} className="text-blue-400 hover:text-blue-300">
+            {useRecoveryCode ? 'Use authenticator code' : 'Use a recovery code'}
+          </button>
+        )}
+        <button type="button" onClick={onCancel} className="text-gray-400 hover:text-white ml-auto">
+          Back to sign in
+        </button>
+      </div>
+    </form>
+  );
+};
@@ -6,14 +6,20 @@ interface StudentProfileFormProps {
   isLoading?: boolean;
 }
 
+// The year is kept as typed and converted on submit
+type StudentProfileFormData = Omit<StudentProfile, 'graduationYear'> & { graduationYear: string };
+
 export const StudentProfileForm = ({ onSubmit, isLoading = false }: StudentProfileFormProps) => {
-  const [formData, setFormData] = useState<StudentProfile>({
+  const [formData, setFormData] = useState<StudentProfileFormData>({
     iin: '',
     lastName: '',
     firstName: '',
     middleName: '',
     phone: '',
-    dateOfBirth: '',
+    birthDate: '',
+    university: '',
+    faculty: '',
+    graduationYear: '',
   });
   const [error, setError] = useState('');
   const [focusedField, setFocusedField] = useState<string | null>(null);
@@ -32,13 +38,18 @@ export const StudentProfileForm = ({ onSubmit, isLoading = false }: StudentProfi
       return;
     }
 
-    if (!formData.firstName || !formData.lastName || !formData.phone || !formData.dateOfBirth) {
+    if (!formData.firstName || !formData.lastName || !formData.phone || !formData.birthDate || !formData.university || !formData.graduationYear) {
       setError('Please fill in all required fields');
       return;
     }
 
     try {
-      await onSubmit(formData);
+      await onSubmit({
+        ...formData,
+        // student-service expects E.164 without separators
+        phone: formData.phone.replace(/[\s()-]/g, ''),
+        graduationYear: Number(formData.graduationYear),
+      });
     } catch (err) {
       setError(err instanceof Error ? err.message : 'Failed to update profile');
     }
@@ -219,26 +230,26 @@ export const StudentProfileForm = ({ onSubmit, isLoading = false }: StudentProfi
 
       {/* Date of Birth */}
       <div>
-        <label htmlFor="dateOfBirth" className="block text-sm font-medium text-emerald-100 mb-2">
+        <label htmlFor="birthDate" className="block text-sm font-medium text-emerald-100 mb-2">
           Date of Birth *
         </label>
         <div className="relative">
-          <div className={`absolute inset-y-0 left-0 pl-4 flex items-center pointer-events-none transition-colors duration-300 ${focusedField === 'dateOfBirth' ? 'text-emerald-400' : 'text-gray-400'}`}>
+          <div className={`absolute inset-y-0 left-0 pl-4 flex items-center pointer-events-none transition-colors duration-300 ${focusedField === 'birthDate' ? 'text-emerald-400' : 'text-gray-400'}`}>
             <svg className="w-5 h-5" fill="none" stroke="currentColor" viewBox="0 0 24 24">
               <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M8 7V3m8 4V3m-9 8h18M5 21h14a2 2 0 002-2V7a2 2 0 00-2-2H5a2 2 0 00-2 2v12a2 2 0 002 2z" />
             </svg>
           </div>
           <input
-            id="dateOfBirth"
-            name="dateOfBirth"
+            id="birthDate"
+            name="birthDate"
             type="date"
-            value={formData.dateOfBirth}
+            value={formData.birthDate}
             onChange={handleChange}
-            onFocus={() => setFocusedField('dateOfBirth')}
+            onFocus={() => setFocusedField('birthDate')}
             onBlur={() => setFocusedField(null)}
             className="w-full pl-12 pr-4 py-3.5 bg-white/10 border border-white/20 rounded-xl text-white placeholder-gray-400 focus:ring-2 focus:ring-emerald-500 focus:border-transparent transition-all duration-300 input-animated backdrop-blur-sm"
           />
-          {formData.dateOfBirth && (
+          {formData.birthDate && (
             <div className="absolute inset-y-0 right-0 pr-4 flex items-center">
               <svg className="w-5 h-5 text-emerald-400" fill="currentColor" viewBox="0 0 20 20">
                 <path fillRule="evenodd" d="M10 18a8 8 0 100-16 8 8 0 000 16zm3.707-9.293a1 1 0 00-1.414-1.414L9 10.586 7.707 9.293a1 1 0 00-1.414 1.414l2 2a1 1 0 001.414 0l4-4z" clipRule="evenodd" />
@@ -248,6 +259,104 @@ export const StudentProfileForm = ({ onSubmit, isLoading = false }: StudentProfi
         </div>
       </div>
 
+      {/* Studies */}
+      <div className="grid grid-cols-3 gap-4">
+        <div>
+          <label htmlFor="university" className="block text-sm font-medium text-emerald-100 mb-2">
+            University *
+          </label>
+          <div className="relative">
+            <div className={`absolute inset-y-0 left-0 pl-4 flex items-center pointer-events-none transition-colors duration-300 ${focusedField === 'university' ? 'text-emerald-400' : 'text-gray-400'}`}>
+              <svg className="w-5 h-5" fill="none" stroke="currentColor" viewBox="0 0 24 24">
+                <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M12 14l9-5-9-5-9 5 9 5z" />
+              </svg>
+            </div>
+            <input
+              id="university"
+              name="university"
+              type="text"
+              placeholder="Al-Farabi KazNU"
+              value={formData.university}
+              onChange={handleChange}
+              onFocus={() => setFocusedField('university')}
+              onBlur={() => setFocusedField(null)}
+              className="w-full pl-12 pr-4 py-3.5 bg-white/10 border border-white/20 rounded-xl text-white placeholder-gray-400 focus:ring-2 focus:ring-emerald-500 focus:border-transparent transition-all duration-300 input-animated backdrop-blur-sm"
+            />
+            {formData.university && (
+              <div className="absolute inset-y-0 right-0 pr-4 flex items-center">
+                <svg className="w-5 h-5 text-emerald-400" fill="currentColor" viewBox="0 0 20 20">
+                  <path fillRule="evenodd" d="M10 18a8 8 0 100-16 8 8 0 000 16zm3.707-9.293a1 1 0 00-1.414-1.414L9 10.586 7.707 9.293a1 1 0 00-1.414 1.414l2 2a1 1 0 001.414 0l4-4z" clipRule="evenodd" />
+                </svg>
+              </div>
+            )}
+          </div>
+        </div>
+
+        <div>
+          <label htmlFor="faculty" className="block text-sm font-medium text-emerald-100 mb-2">
+            Faculty (Optional)
+          </label>
+          <div className="relative">
+            <div className={`absolute inset-y-0 left-0 pl-4 flex items-center pointer-events-none transition-colors duration-300 ${focusedField === 'faculty' ? 'text-emerald-400' : 'text-gray-400'}`}>
+              <svg className="w-5 h-5" fill="none" stroke="currentColor" viewBox="0 0 24 24">
+                <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M12 14l9-5-9-5-9 5 9 5z" />
+              </svg>
+            </div>
+            <input
+              id="faculty"
+              name="faculty"
+              type="text"
+              placeholder="Information Technology"
+              value={formData.faculty}
+              onChange={handleChange}
+              onFocus={() => setFocusedField('faculty')}
+              onBlur={() => setFocusedField(null)}
+              className="w-full pl-12 pr-4 py-3.5 bg-white/10 border border-white/20 rounded-xl text-white placeholder-gray-400 focus:ring-2 focus:ring-emerald-500 focus:border-transparent transition-all duration-300 input-animated backdrop-blur-sm"
+            />
+            {formData.faculty && (
+              <div className="absolute inset-y-0 right-0 pr-4 flex items-center">
+                <svg className="w-5 h-5 text-emerald-400" fill="currentColor" viewBox="0 0 20 20">
+                  <path fillRule="evenodd" d="M10 18a8 8 0 100-16 8 8 0 000 16zm3.707-9.293a1 1 0 00-1.414-1.414L9 10.586 7.707 9.293a1 1 0 00-1.414 1.414l2 2a1 1 0 001.414 0l4-4z" clipRule="evenodd" />
+                </svg>
+              </div>
+            )}
+          </div>
+        </div>
+
+        <div>
+          <label htmlFor="graduationYear" className="block text-sm font-medium text-emerald-100 mb-2">
+            Graduation Year *
+          </label>
+          <div className="relative">
+            <div className={`absolute inset-y-0 left-0 pl-4 flex items-center pointer-events-none transition-colors duration-300 ${focusedField === 'graduationYear' ? 'text-emerald-400' : 'text-gray-400'}`}>
+              <svg className="w-5 h-5" fill="none" stroke="currentColor" viewBox="0 0 24 24">
+                <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M12 14l9-5-9-5-9 5 9 5z" />
+              </svg>
+            </div>
+            <input
+              id="graduationYear"
+              name="graduationYear"
+              type="number"
+              placeholder="2026"
+              min={1950}
+              max={2100}
+              value={formData.graduationYear}
+              onChange={handleChange}
+              onFocus={() => setFocusedField('graduationYear')}
+              onBlur={() => setFocusedField(null)}
+              className="w-full pl-12 pr-4 py-3.5 bg-white/10 border border-white/20 rounded-xl text-white placeholder-gray-400 focus:ring-2 focus:ring-emerald-500 focus:border-transparent transition-all duration-300 input-animated backdrop-blur-sm"
+            />
+            {formData.graduationYear && (
+              <div className="absolute inset-y-0 right-0 pr-4 flex items-center">
+                <svg className="w-5 h-5 text-emerald-400" fill="currentColor" viewBox="0 0 20 20">
+                  <path fillRule="evenodd" d="M10 18a8 8 0 100-16 8 8 0 000 16zm3.707-9.293a1 1 0 00-1.414-1.414L9 10.586 7.707 9.293a1 1 0 00-1.414 1.414l2 2a1 1 0 001.414 0l4-4z" clipRule="evenodd" />
+                </svg>
+              </div>
+            )}
+          </div>
+        </div>
+      </div>
+
       {/* Submit Button */}
       <button
         type="submit"
@@ -4,3 +4,4 @@ export { ProtectedRoute } from './ProtectedRoute';
 export { StudentProfileForm } from './StudentProfileForm';
 export { EmployerProfileForm } from './EmployerProfileForm';
 export { UniversityProfileForm } from './UniversityProfileForm';
+export { MfaLoginForm } from './MfaLoginForm';
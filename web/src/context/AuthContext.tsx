@@ -1,6 +1,6 @@
 import { createContext, useContext, useState, useEffect, useCallback, type ReactNode } from 'react';
 import { authApi } from '../api';
-import type { User, LoginRequest, RegisterRequest, AuthContextType } from '../types/auth';
+import type { User, LoginRequest, RegisterRequest, AuthContextType, AuthResponse, MfaChallenge } from '../types/auth';
 
 const AuthContext = createContext<AuthContextType | undefined>(undefined);
 
@@ -54,8 +54,10 @@ export const AuthProvider = ({ children }: { children: ReactNode }) => {
     initAuth();
   }, []);
 
-  const login = useCallback(async (data: LoginRequest) => {
-    const response = await authApi.login(data);
+  const storeSession = useCallback((response: AuthResponse) => {
+    if (!response.tokens) {
+      throw new Error('Authentication response has no tokens');
+    }
     localStorage.setItem(ACCESS_TOKEN_KEY, response.tokens.access_token);
     localStorage.setItem(REFRESH_TOKEN_KEY, response.tokens.refresh_token);
     setAccessToken(response.tokens.access_token);
@@ -63,16 +65,45 @@ export const AuthProvider = ({ children }: { children: ReactNode }) => {
     setUser(response.user);
   }, []);
 
+  const login = useCallback(async (data: LoginRequest): Promise<MfaChallenge | null> => {
+    const response = await authApi.login(data);
+    if (response.mfa) {
+      return response.mfa;
+    }
+    storeSession(response);
+    return null;
+  }, [storeSession]);
+
+  const completeMfaLogin = useCallback(async (challengeToken: string, code: string, recoveryCode?: string) => {
+    const response = await authApi.verifyMfaLogin(challengeToken, code, recoveryCode);
+    storeSession(response);
+    return response.recovery_codes ?? [];
+  }, [storeSession]);
+
+  const completeOidcLogin = useCallback(async (code: string): Promise<MfaChallenge | null> => {
+    const response = await authApi.exchangeOidcCode(code);
+    if (response.mfa) {
+      return response.mfa;
+    }
+    storeSession(response);
+    return null;
+  }, [storeSession]);
+
   const register = useCallback(async (data: RegisterRequest) => {
     const response = await authApi.register(data);
-    localStorage.setItem(ACCESS_TOKEN_KEY, response.tokens.access_token);
-    localStorage.setItem(REFRESH_TOKEN_KEY, response.tokens.refresh_token);
-    setAccessToken(response.tokens.access_token);
-    setRefreshToken(response.tokens.refresh_token);
-    setUser(response.user);
-  }, []);
+    if (!response.tokens) {
+      return false;
+    }
+    storeSession(response);
+    return true;
+  }, [storeSession]);
 
   const logout = useCallback(() => {
+    // End the server-side session; local state is cleared regardless of the result
+    const token = localStorage.getItem(ACCESS_TOKEN_KEY);
+    if (token) {
+      authApi.logout(token).catch(() => undefined);
+    }
     localStorage.removeItem(ACCESS_TOKEN_KEY);
     localStorage.removeItem(REFRESH_TOKEN_KEY);
     setAccessToken(null);
@@ -81,7 +112,7 @@ export const AuthProvider = ({ children }: { children: ReactNode }) => {
   }, []);
 
   return (
-    <AuthContext.Provider value={{ user, accessToken, refreshToken, isAuthenticated, isLoading, login, register, logout }}>
+    <AuthContext.Provider value={{ user, accessToken, refreshToken, isAuthenticated, isLoading, login, completeMfaLogin, completeOidcLogin, register, logout }}>
       {children}
     </AuthContext.Provider>
   );
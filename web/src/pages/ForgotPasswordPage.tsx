@@ -0,0 +1,61 @@
+import { useState, type FormEvent } from 'react';
+import { Link } from 'react-router-dom';
+import { authApi } from '../api';
+
+const ForgotPasswordPage = () => {
+  const [email, setEmail] = useState('');
+  const [message, setMessage] = useState('');
+  const [error, setError] = useState('');
+  const [isLoading, setIsLoading] = useState(false);
+
+  const handleSubmit = async (e: FormEvent) => {
+    e.preventDefault();
+    setError('');
+    setIsLoading(true);
+
+    try {
+      const response = await authApi.forgotPassword(email);
+      setMessage(response.message);
+    } catch (err) {
+      setError(err instanceof Error ? err.message : 'Request failed');
+    } finally {
+      setIsLoading(false);
+    }
+  };
+
+  return (
+    <div className="min-h-screen flex items-center justify-center bg-gray-50 px-4">
+      <div className="max-w-md w-full bg-white shadow rounded-lg p-8">
+        <h1 className="text-2xl font-bold text-gray-900 mb-2">Forgot password</h1>
+        {message ? (
+          <p className="text-gray-600 mb-6">{message}</p>
+        ) : (
+          <form onSubmit={handleSubmit} className="space-y-4">
+            <p className="text-gray-600">Enter your email and we will send you a link to reset your password.</p>
+            <input
+              type="email"
+              required
+              value={email}
+              onChange={(e) => setEmail(e.target.value)}
+              placeholder="you@example.com"
+              className="w-full px-3 py-2 border border-gray-300 rounded-md focus:outline-none focus:ring-2 focus:ring-blue-500"
+            />
+            {error && <p className="text-sm text-red-600">{error}</p>}
+            <button
+              type="submit"
+              disabled={isLoading}
+              className="w-full px-4 py-2 text-sm font-medium text-white bg-blue-600 rounded-md hover:bg-blue-700 disabled:opacity-50"
+            >
+              {isLoading ? 'Sending...' : 'Send reset link'}
+            </button>
+          </form>
+        )}
+        <Link to="/login" className="block mt-6 text-sm text-blue-600 hover:text-blue-700">
+          Back to sign in
+        </Link>
+      </div>
+    </div>
+  );
+};
+
+export default ForgotPasswordPage;
@@ -1,6 +1,9 @@
-import { useState, type FormEvent, useMemo } from 'react';
+import { useEffect, useState, type FormEvent, useMemo } from 'react';
 import { Link, useNavigate } from 'react-router-dom';
+import { authApi } from '../api';
 import { useAuth } from '../context';
+import { MfaLoginForm } from '../components';
+import type { MfaChallenge, OidcProvider } from '../types/auth';
 
 const LoginPage = () => {
   const navigate = useNavigate();
@@ -12,6 +15,16 @@ const LoginPage = () => {
   const [isLoading, setIsLoading] = useState(false);
   const [showPassword, setShowPassword] = useState(false);
   const [focusedField, setFocusedField] = useState<string | null>(null);
+  const [mfaChallenge, setMfaChallenge] = useState<MfaChallenge | null>(null);
+  const [oidcProviders, setOidcProviders] = useState<OidcProvider[]>([]);
+
+  // University SSO buttons are shown only for configured providers
+  useEffect(() => {
+    authApi
+      .listOidcProviders()
+      .then(setOidcProviders)
+      .catch(() => setOidcProviders([]));
+  }, []);
 
   const handleSubmit = async (e: FormEvent) => {
     e.preventDefault();
@@ -19,7 +32,11 @@ const LoginPage = () => {
     setIsLoading(true);
 
     try {
-      await login({ email, password });
+      const challenge = await login({ email, password });
+      if (challenge) {
+        setMfaChallenge(challenge);
+        return;
+      }
       navigate('/');
     } catch (err) {
       setError(err instanceof Error ? err.message : 'Login failed');
@@ -136,6 +153,17 @@ const LoginPage = () => {
 
           {/* Form Card */}
           <div className="bg-white/10 backdrop-blur-xl rounded-3xl shadow-2xl p-8 border border-white/20">
+            {mfaChallenge ? (
+              <MfaLoginForm
+                challenge={mfaChallenge}
+                onComplete={() => navigate('/')}
+                onCancel={() => {
+                  setMfaChallenge(null);
+                  setPassword('');
+                }}
+              />
+            ) : (
+            <>
             <div className="text-center mb-8 animate-fade-in-up animation-delay-100">
               <h2 className="text-3xl font-bold text-white">Welcome back</h2>
               <p className="text-blue-200/70 mt-2">Please enter your details to sign in</p>
@@ -247,9 +275,9 @@ const LoginPage = () => {
                   <input type="checkbox" className="checkbox-custom" />
                   <span className="ml-3 text-sm text-gray-300 group-hover:text-white transition-colors">Remember me</span>
                 </label>
-                <a href="#" className="text-sm font-medium text-blue-400 hover:text-blue-300 transition-colors">
+                <Link to="/forgot-password" className="text-sm font-medium text-blue-400 hover:text-blue-300 transition-colors">
                   Forgot password?
-                </a>
+                </Link>
               </div>
 
               {/* Submit Button */}
@@ -277,36 +305,39 @@ const LoginPage = () => {
               </button>
             </form>
 
-            {/* Divider */}
-            <div className="my-8 animate-fade-in-up animation-delay-600">
-              <div className="relative">
-                <div className="absolute inset-0 flex items-center">
-                  <div className="w-full border-t border-white/20"></div>
-                </div>
-                <div className="relative flex justify-center text-sm">
-                  <span className="px-4 bg-transparent text-gray-400">Or continue with</span>
+            {oidcProviders.length > 0 && (
+              <>
+                {/* Divider */}
+                <div className="my-8 animate-fade-in-up animation-delay-600">
+                  <div className="relative">
+                    <div className="absolute inset-0 flex items-center">
+                      <div className="w-full border-t border-white/20"></div>
+                    </div>
+                    <div className="relative flex justify-center text-sm">
+                      <span className="px-4 bg-transparent text-gray-400">Or continue with</span>
+                    </div>
+                  </div>
                 </div>
-              </div>
-            </div>
 
-            {/* Social Buttons */}
-            <div className="grid grid-cols-2 gap-4 animate-fade-in-up animation-delay-700">
-              <button className="flex items-center justify-center px-4 py-3 bg-white/10 border border-white/20 rounded-xl hover:bg-white/20 transition-all duration-300 group backdrop-blur-sm">
-                <svg className="w-5 h-5" viewBox="0 0 24 24">
-                  <path fill="#4285F4" d="M22.56 12.25c0-.78-.07-1.53-.2-2.25H12v4.26h5.92c-.26 1.37-1.04 2.53-2.21 3.31v2.77h3.57c2.08-1.92 3.28-4.74 3.28-8.09z"/>
-                  <path fill="#34A853" d="M12 23c2.97 0 5.46-.98 7.28-2.66l-3.57-2.77c-.98.66-2.23 1.06-3.71 1.06-2.86 0-5.29-1.93-6.16-4.53H2.18v2.84C3.99 20.53 7.7 23 12 23z"/>
-                  <path fill="#FBBC05" d="M5.84 14.09c-.22-.66-.35-1.36-.35-2.09s.13-1.43.35-2.09V7.07H2.18C1.43 8.55 1 10.22 1 12s.43 3.45 1.18 4.93l2.85-2.22.81-.62z"/>
-                  <path fill="#EA4335" d="M12 5.38c1.62 0 3.06.56 4.21 1.64l3.15-3.15C17.45 2.09 14.97 1 12 1 7.7 1 3.99 3.47 2.18 7.07l3.66 2.84c.87-2.6 3.3-4.53 6.16-4.53z"/>
-                </svg>
-                <span className="ml-2 text-sm font-medium text-white group-hover:text-white/90">Google</span>
-              </button>
-              <button className="flex items-center justify-center px-4 py-3 bg-white/10 border border-white/20 rounded-xl hover:bg-white/20 transition-all duration-300 group backdrop-blur-sm">
-                <svg className="w-5 h-5 text-white" fill="currentColor" viewBox="0 0 24 24">
-                  <path d="M12 0c-6.626 0-12 5.373-12 12 0 5.302 3.438 9.8 8.207 11.387.599.111.793-.261.793-.577v-2.234c-3.338.726-4.033-1.416-4.033-1.416-.546-1.387-1.333-1.756-1.333-1.756-1.089-.745.083-.729.083-.729 1.205.084 1.839 1.237 1.839 1.237 1.07 1.834 2.807 1.304 3.492.997.107-.775.418-1.305.762-1.604-2.665-.305-5.467-1.334-5.467-5.931 0-1.311.469-2.381 1.236-3.221-.124-.303-.535-1.524.117-3.176 0 0 1.008-.322 3.301 1.23.957-.266 1.983-.399 3.003-.404 1.02.005 2.047.138 3.006.404 2.291-1.552 3.297-1.23 3.297-1.23.653 1.653.242 2.874.118 3.176.77.84 1.235 1.911 1.235 3.221 0 4.609-2.807 5.624-5.479 5.921.43.372.823 1.102.823 2.222v3.293c0 .319.192.694.801.576 4.765-1.589 8.199-6.086 8.199-11.386 0-6.627-5.373-12-12-12z"/>
-                </svg>
-                <span className="ml-2 text-sm font-medium text-white group-hover:text-white/90">GitHub</span>
-              </button>
-            </div>
+                {/* University SSO Buttons */}
+                <div className="space-y-3 animate-fade-in-up animation-delay-700">
+                  {oidcProviders.map((provider) => (
+                    <a
+                      key={provider.name}
+                      href={authApi.oidcLoginUrl(provider.name)}
+                      className="flex items-center justify-center px-4 py-3 bg-white/10 border border-white/20 rounded-xl hover:bg-white/20 transition-all duration-300 group backdrop-blur-sm"
+                    >
+                      <svg className="w-5 h-5 text-white" fill="none" stroke="currentColor" viewBox="0 0 24 24">
+                        <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M12 14l9-5-9-5-9 5 9 5zm0 0l6.16-3.422a12.083 12.083 0 01.665 6.479A11.952 11.952 0 0012 20.055a11.952 11.952 0 00-6.824-2.998 12.078 12.078 0 01.665-6.479L12 14z" />
+                      </svg>
+                      <span className="ml-2 text-sm font-medium text-white group-hover:text-white/90">
+                        Sign in with {provider.display_name}
+                      </span>
+                    </a>
+                  ))}
+                </div>
+              </>
+            )}
 
             {/* Sign up link */}
             <p className="mt-8 text-center text-sm text-gray-400 animate-fade-in-up animation-delay-700">
@@ -315,6 +346,8 @@ const LoginPage = () => {
                 Sign up for free
               </Link>
             </p>
+            </>
+            )}
           </div>
         </div>
       </div>
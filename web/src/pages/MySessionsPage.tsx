@@ -1,7 +1,7 @@
 import { useState } from 'react';
 import { useNavigate } from 'react-router-dom';
 import { useAuth } from '../context';
-import { authApi } from '../api';
+import { authApi, studentsApi } from '../api';
 import { StudentProfileForm, EmployerProfileForm, UniversityProfileForm } from '../components';
 import type { StudentProfile, EmployerProfile, UniversityProfile } from '../types/auth';
 
@@ -20,7 +20,11 @@ const MySessionsPage = () => {
     setIsLoading(true);
     setSuccessMessage('');
     try {
-      await authApi.updateProfile(accessToken, data);
+      if (user.role === 'student') {
+        await studentsApi.saveProfile(accessToken, data as StudentProfile);
+      } else {
+        await authApi.updateProfile(accessToken, data as EmployerProfile | UniversityProfile);
+      }
       setSuccessMessage('Profile updated successfully!');
       setTimeout(() => {
         navigate('/');
@@ -0,0 +1,82 @@
+import { useEffect, useRef, useState } from 'react';
+import { Link, useNavigate, useSearchParams } from 'react-router-dom';
+import { useAuth } from '../context';
+import { MfaLoginForm } from '../components';
+import type { MfaChallenge } from '../types/auth';
+
+// Error codes the backend appends to /oidc/callback when SSO login fails
+const errorMessages: Record<string, string> = {
+  invalid_state: 'The sign-in attempt has expired. Please try again.',
+  email_not_verified: 'Your university account has no verified email address.',
+  registration_not_allowed: 'Only students with a university email can sign up this way. Please register with email and password.',
+  account_exists: 'An account with this email already exists. Please sign in with your email and password.',
+  account_inactive: 'Your account has been deactivated.',
+  account_pending_approval: 'Your account is waiting for administrator approval.',
+  access_denied: 'Sign-in was cancelled.',
+  provider_error: 'The university sign-in service is unavailable. Please try again later.',
+};
+
+const OidcCallbackPage = () => {
+  const navigate = useNavigate();
+  const [searchParams] = useSearchParams();
+  const { completeOidcLogin } = useAuth();
+  const [error, setError] = useState('');
+  const [mfaChallenge, setMfaChallenge] = useState<MfaChallenge | null>(null);
+  // The code is single-use, so guard against the double effect run in StrictMode
+  const requested = useRef(false);
+
+  useEffect(() => {
+    if (requested.current) return;
+    requested.current = true;
+
+    const errorCode = searchParams.get('error');
+    const code = searchParams.get('code');
+    if (errorCode || !code) {
+      setError(errorMessages[errorCode ?? ''] ?? errorMessages.provider_error);
+      return;
+    }
+
+    completeOidcLogin(code)
+      .then((challenge) => {
+        if (challenge) {
+          setMfaChallenge(challenge);
+          return;
+        }
+        navigate('/', { replace: true });
+      })
+      .catch((err) => setError(err instanceof Error ? err.message : 'Sign-in failed'));
+  }, [searchParams, completeOidcLogin, navigate]);
+
+  if (mfaChallenge) {
+    return (
+      <div className="min-h-screen flex items-center justify-center p-6 bg-gradient-to-br from-slate-900 via-blue-900 to-slate-900">
+        <div className="w-full max-w-md bg-white/10 backdrop-blur-xl rounded-3xl shadow-2xl p-8 border border-white/20">
+          <MfaLoginForm
+            challenge={mfaChallenge}
+            onComplete={() => navigate('/', { replace: true })}
+            onCancel={() => navigate('/login', { replace: true })}
+          />
+        </div>
+      </div>
+    );
+  }
+
+  return (
+    <div className="min-h-screen flex items-center justify-center bg-gray-50 px-4">
+      <div className="max-w-md w-full bg-white shadow rounded-lg p-8 text-center">
+        {!error && <p className="text-gray-700">Signing you in...</p>}
+        {error && (
+          <>
+            <h1 className="text-2xl font-bold text-gray-900 mb-2">Sign-in failed</h1>
+            <p className="text-red-600 mb-6">{error}</p>
+            <Link to="/login" className="px-4 py-2 text-sm font-medium text-white bg-blue-600 rounded-md hover:bg-blue-700">
+              Back to sign in
+            </Link>
+          </>
+        )}
+      </div>
+    </div>
+  );
+};
+
+export default OidcCallbackPage;
@@ -1,13 +1,17 @@
 import { useState, type FormEvent, useMemo } from 'react';
-import { Link, useNavigate } from 'react-router-dom';
+import { Link, useNavigate, useSearchParams } from 'react-router-dom';
 import { useAuth } from '../context';
+import { ApiError } from '../api';
 
 const RegisterPage = () => {
   const navigate = useNavigate();
   const { register } = useAuth();
+  const [searchParams] = useSearchParams();
+  // Invitation links from admins carry the token and the invited email
+  const invitationToken = searchParams.get('invitation') ?? '';
 
   const [formData, setFormData] = useState({
-    email: '',
+    email: searchParams.get('email') ?? '',
     password: '',
     confirmPassword: '',
     firstName: '',
@@ -21,6 +25,7 @@ const RegisterPage = () => {
   const [showPassword, setShowPassword] = useState(false);
   const [focusedField, setFocusedField] = useState<string | null>(null);
   const [agreedToTerms, setAgreedToTerms] = useState(false);
+  const [pendingApproval, setPendingApproval] = useState(false);
 
   const handleChange = (e: React.ChangeEvent<HTMLInputElement | HTMLSelectElement>) => {
     const { name, value } = e.target;
@@ -80,15 +85,24 @@ const RegisterPage = () => {
     setIsLoading(true);
 
     try {
-      // Backend only accepts email, password, role
-      await register({
+      // Backend only accepts email, password, role; an invitation decides the role itself
+      const signedIn = await register({
         email: formData.email,
         password: formData.password,
-        role: formData.role,
+        ...(invitationToken ? { invitation_token: invitationToken } : { role: formData.role }),
       });
+      if (!signedIn) {
+        setPendingApproval(true);
+        return;
+      }
       navigate('/');
     } catch (err) {
-      setError(err instanceof Error ? err.message : 'Registration failed');
+      // Password policy violations come back one per rule in details
+      if (err instanceof ApiError && err.details) {
+        setError(Object.values(err.details).join('. '));
+      } else {
+        setError(err instanceof Error ? err.message : 'Registration failed');
+      }
     } finally {
       setIsLoading(false);
     }
@@ -130,6 +144,19 @@ const RegisterPage = () => {
               <p className="text-emerald-200/70 mt-2">Start your journey to find the perfect job</p>
             </div>
 
+            {pendingApproval && (
+              <div className="animate-fade-in-up bg-emerald-500/20 border border-emerald-500/30 text-emerald-100 p-4 rounded-xl mb-5 text-sm">
+                Your university account has been created and is waiting for administrator approval.
+                We will email you once it is reviewed. Please also confirm your email address using the link we sent.
+              </div>
+            )}
+
+            {invitationToken && !pendingApproval && (
+              <div className="animate-fade-in-up bg-blue-500/20 border border-blue-500/30 text-blue-100 p-4 rounded-xl mb-5 text-sm">
+                You have been invited by an administrator. Your role is set by the invitation; register with the invited email address.
+              </div>
+            )}
+
             <form onSubmit={handleSubmit} className="space-y-5">
               {/* Error Message */}
               {error && (
@@ -224,6 +251,9 @@ const RegisterPage = () => {
                   </label>
 
                 </div>
+                {formData.role === 'university' && !invitationToken && (
+                  <p className="mt-2 text-xs text-purple-200/80">University accounts are activated after an administrator reviews them.</p>
+                )}
               </div>
 
               {/* Name Fields */}
@@ -0,0 +1,62 @@
+import { useEffect, useRef, useState } from 'react';
+import { Link, useSearchParams } from 'react-router-dom';
+import { authApi } from '../api';
+
+type Status = 'pending' | 'success' | 'error';
+
+const VerifyEmailPage = () => {
+  const [searchParams] = useSearchParams();
+  const [status, setStatus] = useState<Status>('pending');
+  const [message, setMessage] = useState('');
+  // The token is single-use, so guard against the double effect run in StrictMode
+  const requested = useRef(false);
+
+  useEffect(() => {
+    if (requested.current) return;
+    requested.current = true;
+
+    const token = searchParams.get('token');
+    if (!token) {
+      setStatus('error');
+      setMessage('Verification link is missing a token');
+      return;
+    }
+
+    authApi
+      .verifyEmail(token)
+      .then(() => setStatus('success'))
+      .catch((err) => {
+        setStatus('error');
+        setMessage(err instanceof Error ? err.message : 'Verification failed');
+      });
+  }, [searchParams]);
+
+  return (
+    <div className="min-h-screen flex items-center justify-center bg-gray-50 px-4">
+      <div className="max-w-md w-full bg-white shadow rounded-lg p-8 text-center">
+        {status === 'pending' && <p className="text-gray-700">Verifying your email...</p>}
+        {status === 'success' && (
+          <>
+            <h1 className="text-2xl font-bold text-gray-900 mb-2">Email verified</h1>
+            <p className="text-gray-600 mb-6">
+              Your email has been confirmed. If you are signed in on another tab, reload it to unlock all features.
+            </p>
+          </>
+        )}
+        {status === 'error' && (
+          <>
+            <h1 className="text-2xl font-bold text-gray-900 mb-2">Verification failed</h1>
+            <p className="text-red-600 mb-6">{message}</p>
+          </>
+        )}
+        {status !== 'pending' && (
+          <Link to="/" className="px-4 py-2 text-sm font-medium text-white bg-blue-600 rounded-md hover:bg-blue-700">
+            Go to home page
+          </Link>
+        )}
+      </div>
+    </div>
+  );
+};
+
+export default VerifyEmailPage;
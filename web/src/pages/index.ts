@@ -3,3 +3,7 @@ export { default as HomePage } from './HomePage';
 export { default as LoginPage } from './LoginPage';
 export { default as RegisterPage } from './RegisterPage';
 export { default as MySessionsPage } from './MySessionsPage';
+export { default as VerifyEmailPage } from './VerifyEmailPage';
+export { default as ForgotPasswordPage } from './ForgotPasswordPage';
+export { default as ResetPasswordPage } from './ResetPasswordPage';
+export { default as OidcCallbackPage } from './OidcCallbackPage';
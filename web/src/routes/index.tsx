@@ -1,6 +1,6 @@
 import { createBrowserRouter, RouterProvider } from 'react-router-dom';
 import App from '../App';
-import { HomePage, LoginPage, RegisterPage, MySessionsPage } from '../pages';
+import { HomePage, LoginPage, RegisterPage, MySessionsPage, VerifyEmailPage, ForgotPasswordPage, ResetPasswordPage, OidcCallbackPage } from '../pages';
 import { AuthProvider } from '../context';
 import { ProtectedRoute } from '../components';
 
@@ -13,6 +13,22 @@ const router = createBrowserRouter([
     path: '/register',
     element: <RegisterPage />,
   },
+  {
+    path: '/verify-email',
+    element: <VerifyEmailPage />,
+  },
+  {
+    path: '/forgot-password',
+    element: <ForgotPasswordPage />,
+  },
+  {
+    path: '/reset-password',
+    element: <ResetPasswordPage />,
+  },
+  {
+    path: '/oidc/callback',
+    element: <OidcCallbackPage />,
+  },
   {
     path: '/',
     element: <App />,
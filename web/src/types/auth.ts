@@ -6,6 +6,9 @@ export interface User {
   email: string;
   role: UserRole;
   is_active: boolean;
+  email_verified: boolean;
+  // University accounts registered without an invitation wait for admin approval
+  approval_status: 'approved' | 'pending' | 'rejected';
   created_at: string;
   updated_at: string;
 }
@@ -15,11 +18,13 @@ export interface LoginRequest {
   password: string;
 }
 
-// Backend only accepts email, password, role
+// Backend only accepts email, password, role; with an invitation the role comes from the invitation.
+// Admin accounts are never self-registered
 export interface RegisterRequest {
   email: string;
   password: string;
-  role: UserRole;
+  role?: Exclude<UserRole, 'admin'>;
+  invitation_token?: string;
 }
 
 // Token response from backend
@@ -30,19 +35,43 @@ export interface TokenResponse {
   expires_in: number;
 }
 
-// Auth response matching backend
+// Second login step: TOTP code (or recovery code) is required before tokens are issued
+export interface MfaChallenge {
+  status: 'mfa_required' | 'mfa_setup_required';
+  challenge_token: string;
+  expires_in: number;
+}
+
+export interface MfaSetupResponse {
+  secret: string;
+  otpauth_uri: string;
+}
+
+// University single sign-on provider
+export interface OidcProvider {
+  name: string;
+  display_name: string;
+}
+
+// Auth response matching backend: either tokens or an MFA challenge
 export interface AuthResponse {
   user: User;
-  tokens: TokenResponse;
+  tokens?: TokenResponse;
+  mfa?: MfaChallenge;
+  recovery_codes?: string[];
 }
 
+// Saved by student-service; birthDate is YYYY-MM-DD
 export interface StudentProfile {
   iin: string;
   lastName: string;
   firstName: string;
   middleName?: string;
   phone: string;
-  dateOfBirth: string;
+  birthDate: string;
+  university: string;
+  faculty?: string;
+  graduationYear: number;
 }
 
 export interface EmployerProfile {
@@ -58,7 +87,8 @@ export interface UniversityProfile {
   contactPhone: string;
 }
 
-export type RoleProfile = StudentProfile | EmployerProfile | UniversityProfile;
+// Profiles saved through PUT /api/auth/profile
+export type RoleProfile = EmployerProfile | UniversityProfile;
 
 export interface AuthContextType {
   user: User | null;
@@ -66,7 +96,13 @@ export interface AuthContextType {
   refreshToken: string | null;
   isAuthenticated: boolean;
   isLoading: boolean;
-  login: (data: LoginRequest) => Promise<void>;
-  register: (data: RegisterRequest) => Promise<void>;
+  // Resolves with a challenge when the account requires a second factor
+  login: (data: LoginRequest) => Promise<MfaChallenge | null>;
+  // Resolves with recovery codes when the second factor was enrolled during this login
+  completeMfaLogin: (challengeToken: string, code: string, recoveryCode?: string) => Promise<string[]>;
+  // Finishes a university SSO login with the one-time code from /oidc/callback
+  completeOidcLogin: (code: string) => Promise<MfaChallenge | null>;
+  // Resolves to false when the account was created but awaits admin approval
+  register: (data: RegisterRequest) => Promise<boolean>;
   logout: () => void;
 }
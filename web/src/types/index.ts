@@ -9,21 +9,57 @@ export interface User {
   role: 'student' | 'employer' | 'admin'
 }
 
-// Example: Vacancy types
+// Vacancy types (vacancy-service)
+export type VacancyStatus = 'draft' | 'published' | 'closed'
+
+export type EmploymentType = 'full_time' | 'part_time' | 'internship' | 'contract'
+
 export interface Vacancy {
   id: string
   title: string
   description: string
+  requirements: string
+  location: string
+  employmentType: EmploymentType
+  salaryFrom: number | null
+  salaryTo: number | null
+  currency: string
+  status: VacancyStatus
   employerId: string
+  publishedAt: string | null
+  closedAt: string | null
   createdAt: string
   updatedAt: string
 }
 
-// Example: Application types
+// Application types (vacancy-service)
+export type ApplicationStatus =
+  | 'submitted'
+  | 'screening'
+  | 'interview'
+  | 'offer'
+  | 'hired'
+  | 'rejected'
+  | 'withdrawn'
+
+export interface ApplicationStatusChange {
+  fromStatus?: ApplicationStatus
+  toStatus: ApplicationStatus
+  changedBy: string
+  comment?: string
+  createdAt: string
+}
+
 export interface Application {
   id: string
   studentId: string
   vacancyId: string
-  status: 'pending' | 'accepted' | 'rejected'
+  employerId: string
+  resumeId: string
+  resume: unknown
+  coverLetter: string
+  status: ApplicationStatus
+  history?: ApplicationStatusChange[]
   createdAt: string
+  updatedAt: string
 }